@@ -9,7 +9,7 @@ import (
 
 // GetLink is a helper method to easily get the message link (It will return an empty string in case of private or group chat type).
 func (m Message) GetLink() string {
-	if m.Chat.Type == "private" || m.Chat.Type == "group" {
+	if m.Chat.Type == ChatTypePrivate || m.Chat.Type == ChatTypeGroup {
 		return ""
 	}
 	if m.Chat.Username != "" {
@@ -18,7 +18,7 @@ func (m Message) ParseCaptionEntities() (out []ParsedMessageEntity) {
 }
 
 // ParseEntityTypes calls Message.ParseEntity on a subset of message text entities.
-func (m Message) ParseEntityTypes(accepted map[string]struct{}) (out []ParsedMessageEntity) {
+func (m Message) ParseEntityTypes(accepted map[MessageEntityType]struct{}) (out []ParsedMessageEntity) {
 	utf16Text := utf16.Encode([]rune(m.Text))
 	for _, ent := range m.Entities {
 		if _, ok := accepted[ent.Type]; ok || accepted == nil {
@@ -29,7 +29,7 @@ func (m Message) ParseEntityTypes(accepted map[string]struct{}) (out []ParsedMes
 }
 
 // ParseCaptionEntityTypes calls Message.ParseEntity on a subset of message caption entities.
-func (m Message) ParseCaptionEntityTypes(accepted map[string]struct{}) (out []ParsedMessageEntity) {
+func (m Message) ParseCaptionEntityTypes(accepted map[MessageEntityType]struct{}) (out []ParsedMessageEntity) {
 	utf16Caption := utf16.Encode([]rune(m.Caption))
 	for _, ent := range m.CaptionEntities {
 		if _, ok := accepted[ent.Type]; ok || accepted == nil {
@@ -52,7 +52,7 @@ func (m Message) ParseCaptionEntity(entity MessageEntity) ParsedMessageEntity {
 func parseEntity(entity MessageEntity, utf16Text []uint16) ParsedMessageEntity {
 	text := string(utf16.Decode(utf16Text[entity.Offset : entity.Offset+entity.Length]))
 
-	if entity.Type == "url" {
+	if entity.Type == MessageEntityTypeUrl {
 		entity.Url = text
 	}
 
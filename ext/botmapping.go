@@ -1,6 +1,7 @@
 package ext
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
@@ -25,6 +26,12 @@ type botData struct {
 	updateWriterControl *sync.WaitGroup
 	// stopUpdates allows us to close the stopUpdates loop.
 	stopUpdates chan struct{}
+	// ctx is used for any requests made to fetch updates; it is cancelled when the bot is stopped.
+	ctx context.Context
+	// cancel cancels ctx.
+	cancel context.CancelFunc
+	// polling contains the long polling state. This is nil for webhook bots.
+	polling *pollingState
 
 	// urlPath defines the incoming webhook URL path for this bot.
 	urlPath string
@@ -74,15 +81,22 @@ func (m *botMapping) addBot(b *gotgbot.Bot, urlPath string, webhookSecret string
 		return nil, ErrBotUrlPathAlreadyExists
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
 	bData := botData{
 		bot:                 b,
 		updateChan:          make(chan json.RawMessage),
 		stopUpdates:         make(chan struct{}),
+		ctx:                 ctx,
+		cancel:              cancel,
 		updateWriterControl: &sync.WaitGroup{},
 		urlPath:             urlPath,
 		webhookSecret:       webhookSecret,
 	}
 
+	if urlPath == "" {
+		bData.polling = &pollingState{}
+	}
+
 	m.mapping[bData.bot.Token] = bData
 	m.urlMapping[bData.urlPath] = bData.bot.Token
 	return &bData, nil
@@ -190,7 +204,12 @@ func (m *botMapping) getHandlerFunc(prefix string) func(writer http.ResponseWrit
 			return
 		}
 
-		b.updateChan <- bytes
+		select {
+		case b.updateChan <- bytes:
+		case <-b.stopUpdates:
+			// The bot was stopped before the update could be processed; ask telegram to send it again later.
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
 	}
 }
 
@@ -207,6 +226,10 @@ func (b *botData) stop() {
 	if b.stopUpdates != nil {
 		close(b.stopUpdates)
 	}
+	// Cancel any in-flight getUpdates requests, rather than waiting for them to time out.
+	if b.cancel != nil {
+		b.cancel()
+	}
 
 	// Wait for all writers to finish writing to the updateChannel
 	b.updateWriterControl.Wait()
@@ -1,13 +1,18 @@
 package ext
 
 import (
+	"context"
 	"strings"
+	"time"
 
 	"github.com/PaulSonOfLars/gotgbot/v2"
 )
 
-// TODO: extend to be used as a generic cancel context?
 type Context struct {
+	// context.Context is inlined so that the Context can be passed straight to any of the bot's WithContext methods.
+	// When processed by the Dispatcher, it is cancelled once the update has been processed, once the Dispatcher's
+	// StopWithContext deadline has passed, or once the update has exceeded the Dispatcher's UpdateTimeout.
+	context.Context
 	// gotgbot.Update is inlined so that we can access all fields immediately if necessary.
 	*gotgbot.Update
 	// Bot represents gotgbot.User behind the Bot that received this update, so we can keep track of update ownership.
@@ -17,6 +22,11 @@ type Context struct {
 	// This can be used to pass data across handlers - for example, to cache operations relevant to the current update,
 	// such as admin checks.
 	Data map[string]interface{}
+	// Handler is the handler currently processing the update; this is set once its CheckUpdate method has matched.
+	// This allows for middleware and error handlers to identify the handler, for example through its Name method.
+	Handler Handler
+	// backgroundCtx is the context of the Dispatcher processing the update, which outlives the update itself.
+	backgroundCtx context.Context
 
 	// EffectiveMessage is the message which triggered the update, if available.
 	// If the message is an InaccessibleMessage (eg, from a callbackquery), the message contents may be inaccessible.
@@ -164,6 +174,7 @@ func NewContext(b *gotgbot.Bot, update *gotgbot.Update, data map[string]interfac
 	}
 
 	return &Context{
+		Context:          context.Background(),
 		Update:           update,
 		Bot:              b.User,
 		Data:             data,
@@ -174,6 +185,44 @@ func NewContext(b *gotgbot.Bot, update *gotgbot.Update, data map[string]interfac
 	}
 }
 
+// Deadline implements context.Context. Like the Done, Err and Value methods, it falls back to context.Background() if
+// the inlined context.Context is nil (eg, for Context literals in tests), rather than panicking.
+func (c *Context) Deadline() (time.Time, bool) {
+	return c.context().Deadline()
+}
+
+// Done implements context.Context.
+func (c *Context) Done() <-chan struct{} {
+	return c.context().Done()
+}
+
+// Err implements context.Context.
+func (c *Context) Err() error {
+	return c.context().Err()
+}
+
+// Value implements context.Context.
+func (c *Context) Value(key interface{}) interface{} {
+	return c.context().Value(key)
+}
+
+func (c *Context) context() context.Context {
+	if c.Context == nil {
+		return context.Background()
+	}
+	return c.Context
+}
+
+// BackgroundContext returns a context which outlives the current update, for work which continues after the update
+// has been processed (eg, timers). It is only cancelled once the Dispatcher is stopped.
+// Defaults to context.Background() for contexts which were not processed by a Dispatcher.
+func (c *Context) BackgroundContext() context.Context {
+	if c.backgroundCtx == nil {
+		return context.Background()
+	}
+	return c.backgroundCtx
+}
+
 // Args gets the list of whitespace-separated arguments of the message text.
 func (c *Context) Args() []string {
 	if c.EffectiveMessage == nil {
@@ -1,13 +1,17 @@
 package ext
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"runtime/debug"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/PaulSonOfLars/gotgbot/v2"
 )
@@ -29,6 +33,36 @@ type (
 
 type DispatcherAction string
 
+// SequenceKeyFunc determines the key used to order the processing of an update. Updates with the same key are
+// processed one at a time, in the order in which they were received. Updates with different keys are processed
+// concurrently.
+// Returning an empty key means the update does not need to be ordered, and can be processed straight away.
+type SequenceKeyFunc func(ctx *Context) string
+
+var (
+	// Ensure sequence key methods match the function signatures.
+	_ SequenceKeyFunc = SequenceByChat
+	_ SequenceKeyFunc = SequenceBySender
+)
+
+// SequenceByChat orders the processing of updates from the same chat.
+// Updates without a chat (eg, inline queries) are not ordered.
+func SequenceByChat(ctx *Context) string {
+	if ctx.EffectiveChat == nil {
+		return ""
+	}
+	return strconv.FormatInt(ctx.EffectiveChat.Id, 10)
+}
+
+// SequenceBySender orders the processing of updates from the same sender, across all chats.
+// Updates without a sender (eg, polls) are not ordered.
+func SequenceBySender(ctx *Context) string {
+	if ctx.EffectiveSender == nil || ctx.EffectiveSender.Id() == 0 {
+		return ""
+	}
+	return strconv.FormatInt(ctx.EffectiveSender.Id(), 10)
+}
+
 const (
 	// DispatcherActionNoop stops iteration of current group and moves to the next one.
 	// This is the default action, and the same as would happen if the handler had completed successfully.
@@ -53,6 +87,19 @@ type UpdateDispatcher interface {
 	Stop()
 }
 
+// The AcknowledgingDispatcher interface is implemented by UpdateDispatchers which can report when each update has been
+// fully processed. This is required to track update offsets through an OffsetStore.
+//
+// Updates can be acknowledged in any order, but telegram only stores a single offset: an update is only confirmed once
+// all the updates received before it have been acknowledged too. A slow handler therefore holds back the confirmation
+// of every later update (head-of-line blocking); if the bot restarts in the meantime, those later updates are received
+// again, even if they were already processed.
+type AcknowledgingDispatcher interface {
+	UpdateDispatcher
+	// StartWithAck is the same as Start, but calls ack with the ID of each update once it has been processed.
+	StartWithAck(b *gotgbot.Bot, updates <-chan json.RawMessage, ack func(updateId int64))
+}
+
 // The Dispatcher struct is the default UpdateDispatcher implementation.
 // It supports grouping of update handlers, allowing for powerful update handling flows.
 // Customise the handling of updates by wrapping the Processor struct.
@@ -83,15 +130,74 @@ type Dispatcher struct {
 	// handlers represents all available handlers.
 	handlers handlerMapping
 
+	// ctx is the parent context of all processed updates. It is cancelled when the Dispatcher is stopped.
+	ctx context.Context
+	// cancel cancels the dispatcher context.
+	cancel context.CancelFunc
+	// updateTimeout is the maximum duration of an update's context.
+	updateTimeout time.Duration
+
 	// limiter is how we limit the maximum number of goroutines for handling updates.
 	// if nil, this is a limitless dispatcher.
 	limiter chan struct{}
 	// waitGroup handles the number of running operations to allow for clean shutdowns.
 	waitGroup sync.WaitGroup
+
+	// sequenceKey determines how to order the processing of updates. If nil, updates are not ordered.
+	sequenceKey SequenceKeyFunc
+	// sequences contains the queue of pending updates for each sequence key currently being processed.
+	sequences map[string][]*pendingUpdate
+	// sequenceMux ensures the sequences map is concurrency-safe.
+	sequenceMux sync.Mutex
+
+	// pending contains all the updates received through Start which have not finished processing yet.
+	pending map[*pendingUpdate]struct{}
+	// pendingMux ensures the pending map is concurrency-safe.
+	pendingMux sync.Mutex
+}
+
+// pendingUpdate is an update received through Dispatcher.Start, which has not finished processing yet.
+type pendingUpdate struct {
+	// bot is the bot which received the update.
+	bot *gotgbot.Bot
+	// raw is the update, as received.
+	raw json.RawMessage
+	// ctx is the update context, if the update has already been unmarshalled.
+	ctx *Context
+	// ack is called with the update ID once the update has been processed, if set.
+	ack func(updateId int64)
+}
+
+// rawUpdateId gets the ID of an update, without unmarshalling the entire update.
+func rawUpdateId(raw json.RawMessage) (int64, bool) {
+	var upd struct {
+		UpdateId int64 `json:"update_id"`
+	}
+	if err := json.Unmarshal(raw, &upd); err != nil {
+		return 0, false
+	}
+	return upd.UpdateId, true
+}
+
+// AbandonedUpdatesError is returned when the Dispatcher is stopped before all updates could be processed.
+type AbandonedUpdatesError struct {
+	// Updates contains the IDs of the abandoned updates, grouped by the ID of the bot which received them.
+	Updates map[int64][]int64
+}
+
+func (e *AbandonedUpdatesError) Error() string {
+	count := 0
+	for _, ids := range e.Updates {
+		count += len(ids)
+	}
+	return fmt.Sprintf("stopped before %d updates could be processed", count)
 }
 
 // Ensure compile-time type safety.
-var _ UpdateDispatcher = &Dispatcher{}
+var (
+	_ UpdateDispatcher        = &Dispatcher{}
+	_ AcknowledgingDispatcher = &Dispatcher{}
+)
 
 // DispatcherOpts can be used to configure or override default Dispatcher behaviours.
 type DispatcherOpts struct {
@@ -120,6 +226,22 @@ type DispatcherOpts struct {
 	// If MaxRoutines < 0, no limits are imposed.
 	// If MaxRoutines > 0, that value is used.
 	MaxRoutines int
+
+	// Context is the parent context of the context passed to handlers through ext.Context.
+	// The handler contexts are cancelled when this context is cancelled, or when StopWithContext stops waiting for
+	// them.
+	// Defaults to context.Background().
+	Context context.Context
+	// UpdateTimeout is the maximum duration for processing each update, after which the update's context is cancelled.
+	// If 0, no timeout is applied.
+	UpdateTimeout time.Duration
+
+	// SequenceKey enables ordered processing of updates. Updates with the same key (eg, from the same chat, see
+	// SequenceByChat) are processed one at a time, in the order in which they were received; updates with different
+	// keys are still processed concurrently, within the limits of MaxRoutines.
+	// Note: updates waiting for their turn do not count towards MaxRoutines.
+	// If nil, all updates are processed concurrently, in no particular order.
+	SequenceKey SequenceKeyFunc
 }
 
 // NewDispatcher creates a new Dispatcher, which process and handles incoming updates from the updates channel.
@@ -128,6 +250,9 @@ func NewDispatcher(opts *DispatcherOpts) *Dispatcher {
 	var panicHandler DispatcherPanicHandler
 	var unhandledErrFunc ErrorFunc
 	var errLog *log.Logger
+	var sequenceKey SequenceKeyFunc
+	var updateTimeout time.Duration
+	parentCtx := context.Background()
 
 	maxRoutines := DefaultMaxRoutines
 	processor := Processor(BaseProcessor{})
@@ -144,6 +269,11 @@ func NewDispatcher(opts *DispatcherOpts) *Dispatcher {
 		panicHandler = opts.Panic
 		unhandledErrFunc = opts.UnhandledErrFunc
 		errLog = opts.ErrorLog
+		sequenceKey = opts.SequenceKey
+		updateTimeout = opts.UpdateTimeout
+		if opts.Context != nil {
+			parentCtx = opts.Context
+		}
 	}
 
 	var limiter chan struct{}
@@ -156,6 +286,8 @@ func NewDispatcher(opts *DispatcherOpts) *Dispatcher {
 		limiter = make(chan struct{}, maxRoutines)
 	}
 
+	ctx, cancel := context.WithCancel(parentCtx)
+
 	return &Dispatcher{
 		Processor:        processor,
 		Error:            errHandler,
@@ -163,8 +295,14 @@ func NewDispatcher(opts *DispatcherOpts) *Dispatcher {
 		UnhandledErrFunc: unhandledErrFunc,
 		ErrorLog:         errLog,
 		handlers:         handlerMapping{},
+		ctx:              ctx,
+		cancel:           cancel,
+		updateTimeout:    updateTimeout,
 		limiter:          limiter,
 		waitGroup:        sync.WaitGroup{},
+		sequenceKey:      sequenceKey,
+		sequences:        map[string][]*pendingUpdate{},
+		pending:          map[*pendingUpdate]struct{}{},
 	}
 }
 
@@ -189,9 +327,20 @@ func (d *Dispatcher) MaxUsage() int {
 // Start to handle incoming updates.
 // This is a blocking method; it should be called as a goroutine, such that it can receive incoming updates.
 func (d *Dispatcher) Start(b *gotgbot.Bot, updates <-chan json.RawMessage) {
+	d.StartWithAck(b, updates, nil)
+}
+
+// StartWithAck is the same as Start, but calls ack with the ID of each update once it has been processed; including
+// updates which failed to be unmarshalled, or whose handlers returned errors.
+func (d *Dispatcher) StartWithAck(b *gotgbot.Bot, updates <-chan json.RawMessage, ack func(updateId int64)) {
 	// Listen to updates as they come in from the updater.
 	for upd := range updates {
-		d.waitGroup.Add(1)
+		p := d.addPending(b, upd, ack)
+
+		if d.sequenceKey != nil {
+			d.startSequenced(b, p)
+			continue
+		}
 
 		// If a limiter has been set, we use it to control the number of concurrent updates being processed.
 		if d.limiter != nil {
@@ -200,37 +349,226 @@ func (d *Dispatcher) Start(b *gotgbot.Bot, updates <-chan json.RawMessage) {
 			d.limiter <- struct{}{}
 		}
 
-		go func(upd json.RawMessage) {
+		go func(p *pendingUpdate) {
 			// We defer here so that whatever happens, we can clean up the dispatcher.
 			defer func() {
 				if d.limiter != nil {
 					// Pop an item from the limiter, allowing another update to process.
 					<-d.limiter
 				}
-				d.waitGroup.Done()
+				d.donePending(p)
 			}()
 
-			err := d.processRawUpdate(b, upd)
+			err := d.processPending(b, p)
 			if err != nil {
-				if d.UnhandledErrFunc != nil {
-					d.UnhandledErrFunc(err)
-				} else {
-					d.logf("Failed to process update: %s", err.Error())
-				}
+				d.handleUnprocessedUpdate(err)
 			}
 
-		}(upd)
+		}(p)
+	}
+}
+
+// addPending keeps track of an incoming update until it has been processed.
+func (d *Dispatcher) addPending(b *gotgbot.Bot, upd json.RawMessage, ack func(updateId int64)) *pendingUpdate {
+	d.waitGroup.Add(1)
+
+	p := &pendingUpdate{bot: b, raw: upd, ack: ack}
+
+	d.pendingMux.Lock()
+	defer d.pendingMux.Unlock()
+	d.pending[p] = struct{}{}
+	return p
+}
+
+// donePending marks a pending update as processed.
+func (d *Dispatcher) donePending(p *pendingUpdate) {
+	d.pendingMux.Lock()
+	delete(d.pending, p)
+	d.pendingMux.Unlock()
+
+	if p.ack != nil {
+		if p.ctx != nil {
+			p.ack(p.ctx.UpdateId)
+		} else if id, ok := rawUpdateId(p.raw); ok {
+			p.ack(id)
+		}
+	}
+
+	d.waitGroup.Done()
+}
+
+// processPending unmarshals a pending update, and processes it.
+func (d *Dispatcher) processPending(b *gotgbot.Bot, p *pendingUpdate) error {
+	if err := d.unmarshalPending(b, p); err != nil {
+		return err
+	}
+	return d.processContext(b, p.ctx)
+}
+
+// unmarshalPending builds the context of a pending update.
+func (d *Dispatcher) unmarshalPending(b *gotgbot.Bot, p *pendingUpdate) error {
+	var u gotgbot.Update
+	if err := json.Unmarshal(p.raw, &u); err != nil {
+		return fmt.Errorf("failed to unmarshal update: %w", err)
+	}
+
+	ctx := NewContext(b, &u, nil)
+
+	d.pendingMux.Lock()
+	defer d.pendingMux.Unlock()
+	p.ctx = ctx
+	return nil
+}
+
+// abandonedUpdates returns the IDs of all the updates which are still pending, grouped by bot ID.
+func (d *Dispatcher) abandonedUpdates() map[int64][]int64 {
+	d.pendingMux.Lock()
+	defer d.pendingMux.Unlock()
+
+	abandoned := make(map[int64][]int64, len(d.pending))
+	for p := range d.pending {
+		var botId int64
+		if p.bot != nil {
+			botId = p.bot.Id
+		}
+
+		if p.ctx != nil {
+			abandoned[botId] = append(abandoned[botId], p.ctx.UpdateId)
+			continue
+		}
+
+		// The update has not been unmarshalled yet; only get the ID.
+		// Invalid updates are skipped, since they would have been skipped anyway.
+		if id, ok := rawUpdateId(p.raw); ok {
+			abandoned[botId] = append(abandoned[botId], id)
+		}
+	}
+
+	for _, ids := range abandoned {
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	}
+	return abandoned
+}
+
+// startSequenced processes an incoming update, while making sure that updates with the same sequence key are
+// processed in order.
+func (d *Dispatcher) startSequenced(b *gotgbot.Bot, p *pendingUpdate) {
+	if err := d.unmarshalPending(b, p); err != nil {
+		d.handleUnprocessedUpdate(err)
+		d.donePending(p)
+		return
+	}
+
+	key := d.sequenceKey(p.ctx)
+	if key != "" {
+		// Make sure different bots sharing a dispatcher don't share sequences.
+		key = strconv.FormatInt(p.ctx.Bot.Id, 10) + "/" + key
+
+		d.sequenceMux.Lock()
+		if queue, ok := d.sequences[key]; ok {
+			// Updates with this key are already being processed; queue it for its turn.
+			d.sequences[key] = append(queue, p)
+			d.sequenceMux.Unlock()
+			return
+		}
+		// Mark this key as being processed.
+		d.sequences[key] = nil
+		d.sequenceMux.Unlock()
+	}
+
+	// If a limiter has been set, we use it to control the number of concurrent sequences being processed.
+	if d.limiter != nil {
+		d.limiter <- struct{}{}
+	}
+
+	go func() {
+		defer func() {
+			if d.limiter != nil {
+				// Pop an item from the limiter, allowing another sequence to process.
+				<-d.limiter
+			}
+		}()
+
+		for p != nil {
+			err := d.processContext(b, p.ctx)
+			if err != nil {
+				d.handleUnprocessedUpdate(err)
+			}
+
+			var next *pendingUpdate
+			if key != "" {
+				next = d.nextInSequence(key)
+			}
+			// Only mark the update as done once the sequence has been updated, so stopping is always clean.
+			d.donePending(p)
+			p = next
+		}
+	}()
+}
+
+// nextInSequence returns the next update to process for the sequence key. If there are none, the sequence is ended,
+// and nil is returned.
+func (d *Dispatcher) nextInSequence(key string) *pendingUpdate {
+	d.sequenceMux.Lock()
+	defer d.sequenceMux.Unlock()
+
+	queue := d.sequences[key]
+	if len(queue) == 0 {
+		delete(d.sequences, key)
+		return nil
+	}
+
+	d.sequences[key] = queue[1:]
+	return queue[0]
+}
+
+// handleUnprocessedUpdate passes the error to UnhandledErrFunc, or logs it.
+func (d *Dispatcher) handleUnprocessedUpdate(err error) {
+	if d.UnhandledErrFunc != nil {
+		d.UnhandledErrFunc(err)
+	} else {
+		d.logf("Failed to process update: %s", err.Error())
 	}
 }
 
 // Stop waits for all currently processing updates to finish, and then returns.
+// The update contexts are only cancelled once all updates have been processed; use StopWithContext to abort any
+// long-running handlers after a deadline.
 func (d *Dispatcher) Stop() {
 	d.waitGroup.Wait()
+	d.cancel()
 	if d.limiter != nil {
 		close(d.limiter)
 	}
 }
 
+// StopWithContext waits for all currently processing updates to finish, and then returns. If the context is done
+// before then, the context of the remaining updates is cancelled, and an AbandonedUpdatesError is returned without
+// waiting any further.
+// Note: updates channels passed to Start should be closed first, to stop any new updates from being processed.
+func (d *Dispatcher) StopWithContext(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		d.waitGroup.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		d.cancel()
+		if d.limiter != nil {
+			close(d.limiter)
+		}
+		return nil
+
+	case <-ctx.Done():
+		// Some handlers are still running; let them know they should abort.
+		// The limiter is left open, since these will still release it once they return.
+		d.cancel()
+		return &AbandonedUpdatesError{Updates: d.abandonedUpdates()}
+	}
+}
+
 // AddHandler adds a new handler to the dispatcher. The dispatcher will call CheckUpdate() to see whether the handler
 // should be executed, and then HandleUpdate() to execute it.
 func (d *Dispatcher) AddHandler(handler Handler) {
@@ -242,6 +580,18 @@ func (d *Dispatcher) AddHandlerToGroup(h Handler, group int) {
 	d.handlers.add(h, group)
 }
 
+// Use adds middleware to be applied to all handlers, in all groups. Middleware is called in the order in which it was
+// added, and before any group-specific middleware.
+func (d *Dispatcher) Use(mw ...Middleware) {
+	d.handlers.use(mw...)
+}
+
+// UseInGroup adds middleware to be applied to all handlers in a specific group.
+// Group middleware is called after the middleware added through Use.
+func (d *Dispatcher) UseInGroup(group int, mw ...Middleware) {
+	d.handlers.useInGroup(group, mw...)
+}
+
 // RemoveHandlerFromGroup removes a handler by name from the specified group.
 // If multiple handlers have the same name, only the first one is removed.
 // Returns true if the handler was successfully removed.
@@ -255,20 +605,23 @@ func (d *Dispatcher) RemoveGroup(group int) bool {
 	return d.handlers.removeGroup(group)
 }
 
-// processRawUpdate takes a JSON update to be unmarshalled and processed by Dispatcher.ProcessUpdate.
-func (d *Dispatcher) processRawUpdate(b *gotgbot.Bot, r json.RawMessage) error {
-	var upd gotgbot.Update
-	if err := json.Unmarshal(r, &upd); err != nil {
-		return fmt.Errorf("failed to unmarshal update: %w", err)
-	}
-
-	return d.ProcessUpdate(b, &upd, nil)
-}
-
 // ProcessUpdate iterates over the list of groups to execute the matching handlers.
 // This is also where we recover from any panics that are thrown by user code, to avoid taking down the bot.
-func (d *Dispatcher) ProcessUpdate(b *gotgbot.Bot, u *gotgbot.Update, data map[string]interface{}) (err error) {
-	ctx := NewContext(b, u, data)
+func (d *Dispatcher) ProcessUpdate(b *gotgbot.Bot, u *gotgbot.Update, data map[string]interface{}) error {
+	return d.processContext(b, NewContext(b, u, data))
+}
+
+// processContext executes the matching handlers for an update context, recovering from any panics.
+func (d *Dispatcher) processContext(b *gotgbot.Bot, ctx *Context) (err error) {
+	ctx.backgroundCtx = d.ctx
+
+	var cancel context.CancelFunc
+	if d.updateTimeout > 0 {
+		ctx.Context, cancel = context.WithTimeout(d.ctx, d.updateTimeout)
+	} else {
+		ctx.Context, cancel = context.WithCancel(d.ctx)
+	}
+	defer cancel()
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -292,14 +645,21 @@ func (d *Dispatcher) ProcessUpdate(b *gotgbot.Bot, u *gotgbot.Update, data map[s
 }
 
 func (d *Dispatcher) iterateOverHandlerGroups(b *gotgbot.Bot, ctx *Context) error {
-	for _, groups := range d.handlers.getGroups() {
+	allHandlers, allMiddleware := d.handlers.getGroupsWithMiddleware()
+	for idx, groups := range allHandlers {
 		for _, handler := range groups {
 			if !handler.CheckUpdate(b, ctx) {
 				// Handler filter doesn't match this update; continue.
 				continue
 			}
 
-			err := handler.HandleUpdate(b, ctx)
+			ctx.Handler = handler
+			var err error
+			if mw := allMiddleware[idx]; len(mw) > 0 {
+				err = ChainMiddleware(handler.HandleUpdate, mw...)(b, ctx)
+			} else {
+				err = handler.HandleUpdate(b, ctx)
+			}
 			if err != nil {
 				if errors.Is(err, ContinueGroups) {
 					// Continue handling current group.
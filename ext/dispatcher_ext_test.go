@@ -1,7 +1,9 @@
 package ext_test
 
 import (
+	"fmt"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/PaulSonOfLars/gotgbot/v2"
@@ -180,3 +182,82 @@ func TestDispatcher_RemoveHandlerHandlerFromNonExistingGroup(t *testing.T) {
 		t.Errorf("RemoveHandlerFromGroup() = %v, want false", found)
 	}
 }
+
+func TestDispatcher_Middleware(t *testing.T) {
+	d := ext.NewDispatcher(nil)
+
+	var events []string
+	record := func(name string) ext.Middleware {
+		return func(next ext.HandlerFunc) ext.HandlerFunc {
+			return func(b *gotgbot.Bot, ctx *ext.Context) error {
+				events = append(events, name+":"+ctx.Handler.Name())
+				return next(b, ctx)
+			}
+		}
+	}
+
+	d.Use(record("global"))
+	d.UseInGroup(0, record("group"))
+	d.AddHandler(handlers.NewMiddlewareHandler(
+		handlers.NewNamedhandler("first", handlers.NewMessage(message.All, func(b *gotgbot.Bot, ctx *ext.Context) error {
+			events = append(events, fmt.Sprintf("handler:%v", ctx.Data["lang"]))
+			return nil
+		})),
+		func(next ext.HandlerFunc) ext.HandlerFunc {
+			return func(b *gotgbot.Bot, ctx *ext.Context) error {
+				ctx.Data["lang"] = "en"
+				return next(b, ctx)
+			}
+		},
+	))
+	d.AddHandlerToGroup(handlers.NewNamedhandler("second", handlers.NewMessage(message.All, func(b *gotgbot.Bot, ctx *ext.Context) error {
+		events = append(events, "handler")
+		return nil
+	})), 1)
+
+	err := d.ProcessUpdate(&gotgbot.Bot{}, &gotgbot.Update{
+		Message: &gotgbot.Message{Text: "test text"},
+	}, nil)
+	if err != nil {
+		t.Errorf("Unexpected error while processing updates: %s", err.Error())
+	}
+
+	expected := []string{"global:first", "group:first", "handler:en", "global:second", "handler"}
+	if strings.Join(events, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected events %v, got %v", expected, events)
+	}
+}
+
+func TestDispatcher_MiddlewareShortCircuit(t *testing.T) {
+	d := ext.NewDispatcher(nil)
+
+	// Only allow the "allowed" handler to run; others are skipped, letting the rest of the group be checked.
+	d.Use(func(next ext.HandlerFunc) ext.HandlerFunc {
+		return func(b *gotgbot.Bot, ctx *ext.Context) error {
+			if ctx.Handler.Name() != "allowed" {
+				return ext.ContinueGroups
+			}
+			return next(b, ctx)
+		}
+	})
+
+	var ran []string
+	for _, name := range []string{"blocked", "allowed"} {
+		name := name
+		d.AddHandler(handlers.NewNamedhandler(name, handlers.NewMessage(message.All, func(b *gotgbot.Bot, ctx *ext.Context) error {
+			ran = append(ran, name)
+			return nil
+		})))
+	}
+
+	err := d.ProcessUpdate(&gotgbot.Bot{}, &gotgbot.Update{
+		Message: &gotgbot.Message{Text: "test text"},
+	}, nil)
+	if err != nil {
+		t.Errorf("Unexpected error while processing updates: %s", err.Error())
+	}
+
+	if len(ran) != 1 || ran[0] != "allowed" {
+		t.Errorf("expected only the allowed handler to run, got %v", ran)
+	}
+}
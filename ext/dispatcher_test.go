@@ -1,7 +1,9 @@
 package ext
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"sync"
 	"testing"
 	"time"
@@ -86,3 +88,194 @@ func BenchmarkDispatcher(b *testing.B) {
 	wg.Wait()
 	d.Stop()
 }
+
+func TestSequencedDispatcher(t *testing.T) {
+	d := NewDispatcher(&DispatcherOpts{
+		SequenceKey: SequenceByChat,
+		MaxRoutines: 2,
+	})
+
+	unblock := make(chan struct{})
+	wg := sync.WaitGroup{}
+	mux := sync.Mutex{}
+	processed := map[int64][]int64{}
+
+	d.AddHandler(DummyHandler{F: func(b *gotgbot.Bot, ctx *Context) error {
+		defer wg.Done()
+		if ctx.UpdateId == 1 {
+			// Block the first update of chat 1 until chat 2 has been handled, to show that different chats are
+			// processed concurrently.
+			<-unblock
+		}
+		if ctx.EffectiveChat.Id == 2 {
+			close(unblock)
+		}
+
+		mux.Lock()
+		defer mux.Unlock()
+		processed[ctx.EffectiveChat.Id] = append(processed[ctx.EffectiveChat.Id], ctx.UpdateId)
+		return nil
+	}})
+
+	updateChan := make(chan json.RawMessage)
+	go d.Start(&gotgbot.Bot{}, updateChan)
+
+	for i, chatId := range []int64{1, 1, 1, 1, 2} {
+		upd, err := json.Marshal(gotgbot.Update{
+			UpdateId: int64(i + 1),
+			Message:  &gotgbot.Message{Text: "test", Chat: gotgbot.Chat{Id: chatId}},
+		})
+		if err != nil {
+			t.Fatalf("failed to marshal test msg: %s", err.Error())
+		}
+		wg.Add(1)
+		updateChan <- upd
+	}
+
+	wg.Wait()
+	d.Stop()
+
+	if len(processed[1]) != 4 || processed[1][0] != 1 || processed[1][1] != 2 || processed[1][2] != 3 || processed[1][3] != 4 {
+		t.Errorf("expected chat 1 updates to be processed in order, got %v", processed[1])
+	}
+	if len(processed[2]) != 1 {
+		t.Errorf("expected chat 2 update to be processed, got %v", processed[2])
+	}
+	if len(d.sequences) != 0 {
+		t.Errorf("expected all sequences to be cleaned up, got %d", len(d.sequences))
+	}
+}
+
+func TestDispatcherUpdateTimeout(t *testing.T) {
+	d := NewDispatcher(&DispatcherOpts{
+		UpdateTimeout: time.Millisecond * 10,
+	})
+
+	d.AddHandler(DummyHandler{F: func(b *gotgbot.Bot, ctx *Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}})
+
+	var handlerErr error
+	d.Error = func(b *gotgbot.Bot, ctx *Context, err error) DispatcherAction {
+		handlerErr = err
+		return DispatcherActionNoop
+	}
+
+	err := d.ProcessUpdate(&gotgbot.Bot{}, &gotgbot.Update{Message: &gotgbot.Message{Text: "test"}}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !errors.Is(handlerErr, context.DeadlineExceeded) {
+		t.Errorf("expected handler context to exceed its deadline, got: %v", handlerErr)
+	}
+}
+
+func TestDispatcherStopWaitsForHandlers(t *testing.T) {
+	d := NewDispatcher(nil)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var handlerErr error
+	d.AddHandler(DummyHandler{F: func(b *gotgbot.Bot, ctx *Context) error {
+		close(started)
+		<-release
+		handlerErr = ctx.Err()
+		return nil
+	}})
+
+	updateChan := make(chan json.RawMessage)
+	go d.Start(&gotgbot.Bot{}, updateChan)
+
+	upd, err := json.Marshal(gotgbot.Update{Message: &gotgbot.Message{Text: "test"}})
+	if err != nil {
+		t.Fatalf("failed to marshal test msg: %s", err.Error())
+	}
+	updateChan <- upd
+	<-started
+
+	stopped := make(chan struct{})
+	go func() {
+		d.Stop()
+		close(stopped)
+	}()
+
+	// Stop is graceful; the handler keeps its context until it returns.
+	close(release)
+	<-stopped
+	if handlerErr != nil {
+		t.Errorf("expected handler context not to be cancelled by Stop, got: %v", handlerErr)
+	}
+}
+
+func TestDispatcherStopWithContextCancelsContext(t *testing.T) {
+	d := NewDispatcher(nil)
+
+	started := make(chan struct{})
+	handlerErr := make(chan error, 1)
+	d.AddHandler(DummyHandler{F: func(b *gotgbot.Bot, ctx *Context) error {
+		close(started)
+		<-ctx.Done()
+		handlerErr <- ctx.Err()
+		return nil
+	}})
+
+	updateChan := make(chan json.RawMessage)
+	go d.Start(&gotgbot.Bot{}, updateChan)
+
+	upd, err := json.Marshal(gotgbot.Update{Message: &gotgbot.Message{Text: "test"}})
+	if err != nil {
+		t.Fatalf("failed to marshal test msg: %s", err.Error())
+	}
+	updateChan <- upd
+	<-started
+
+	// Once the deadline has passed, the running handler should be cancelled, rather than waited for forever.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var abandonedErr *AbandonedUpdatesError
+	if err := d.StopWithContext(ctx); !errors.As(err, &abandonedErr) {
+		t.Errorf("expected an abandoned updates error, got: %v", err)
+	}
+	if err := <-handlerErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected handler context to be cancelled, got: %v", err)
+	}
+}
+
+func TestContextWithoutParent(t *testing.T) {
+	// Context literals don't have a parent context; they should behave like context.Background().
+	ctx := &Context{}
+	if ctx.Done() != nil || ctx.Err() != nil || ctx.Value("key") != nil {
+		t.Errorf("expected a context without parent to never be done")
+	}
+	if _, ok := ctx.Deadline(); ok {
+		t.Errorf("expected a context without parent to have no deadline")
+	}
+	if ctx.BackgroundContext() == nil {
+		t.Errorf("expected a background context to always be available")
+	}
+}
+
+func TestContextBackgroundContext(t *testing.T) {
+	d := NewDispatcher(nil)
+
+	var bgCtx context.Context
+	d.AddHandler(DummyHandler{F: func(b *gotgbot.Bot, ctx *Context) error {
+		bgCtx = ctx.BackgroundContext()
+		return nil
+	}})
+
+	err := d.ProcessUpdate(&gotgbot.Bot{}, &gotgbot.Update{Message: &gotgbot.Message{Text: "test"}}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// The background context outlives the update, but not the dispatcher.
+	if bgCtx.Err() != nil {
+		t.Errorf("expected the background context to outlive the update, got: %v", bgCtx.Err())
+	}
+	d.Stop()
+	if !errors.Is(bgCtx.Err(), context.Canceled) {
+		t.Errorf("expected the background context to be cancelled once the dispatcher stops, got: %v", bgCtx.Err())
+	}
+}
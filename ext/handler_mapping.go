@@ -13,6 +13,11 @@ type handlerMapping struct {
 	handlerGroups []int
 	// handlers represents all available handlers, split into groups (see handlerGroups).
 	handlers map[int][]Handler
+
+	// middleware is applied to all handlers.
+	middleware []Middleware
+	// groupMiddleware is applied to all handlers of a specific group. This is kept even if the group is removed.
+	groupMiddleware map[int][]Middleware
 }
 
 func (m *handlerMapping) add(h Handler, group int) {
@@ -100,13 +105,51 @@ func (m *handlerMapping) removeGroup(group int) bool {
 	return false
 }
 
+func (m *handlerMapping) use(mw ...Middleware) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	// Copy the middleware list to avoid changing the underlying arrays while in use.
+	newMiddleware := make([]Middleware, 0, len(m.middleware)+len(mw))
+	m.middleware = append(append(newMiddleware, m.middleware...), mw...)
+}
+
+func (m *handlerMapping) useInGroup(group int, mw ...Middleware) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.groupMiddleware == nil {
+		m.groupMiddleware = map[int][]Middleware{}
+	}
+	// Copy the middleware list to avoid changing the underlying arrays while in use.
+	currMiddleware := m.groupMiddleware[group]
+	newMiddleware := make([]Middleware, 0, len(currMiddleware)+len(mw))
+	m.groupMiddleware[group] = append(append(newMiddleware, currMiddleware...), mw...)
+}
+
 func (m *handlerMapping) getGroups() [][]Handler {
+	allHandlers, _ := m.getGroupsWithMiddleware()
+	return allHandlers
+}
+
+// getGroupsWithMiddleware returns all handler groups, as well as the middleware to apply to each group. The middleware
+// for each group contains the global middleware, followed by the group-specific middleware.
+func (m *handlerMapping) getGroupsWithMiddleware() ([][]Handler, [][]Middleware) {
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
 
 	allHandlers := make([][]Handler, len(m.handlerGroups))
+	allMiddleware := make([][]Middleware, len(m.handlerGroups))
 	for idx, num := range m.handlerGroups {
 		allHandlers[idx] = m.handlers[num]
+
+		groupMiddleware := m.groupMiddleware[num]
+		if len(groupMiddleware) == 0 {
+			allMiddleware[idx] = m.middleware
+			continue
+		}
+		allMiddleware[idx] = make([]Middleware, 0, len(m.middleware)+len(groupMiddleware))
+		allMiddleware[idx] = append(append(allMiddleware[idx], m.middleware...), groupMiddleware...)
 	}
-	return allHandlers
+	return allHandlers, allMiddleware
 }
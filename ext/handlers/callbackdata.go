@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/callbackdata"
+)
+
+// CallbackDataResponse is the response of a CallbackData handler, which receives the decoded callback data.
+type CallbackDataResponse[T any] func(b *gotgbot.Bot, ctx *ext.Context, data T) error
+
+// CallbackData handles callback queries whose data was encoded by a callbackdata.Codec. The data is decoded before
+// being passed to the response; callback data which cannot be decoded (eg, because the signature is invalid) is
+// returned as an error to the dispatcher.
+type CallbackData[T any] struct {
+	AllowChannel bool
+	// Codec is used to match and decode the callback data.
+	Codec *callbackdata.Codec[T]
+	// Filter allows for only handling some of the decoded values. If set, callback data which cannot be decoded is
+	// not handled.
+	Filter   func(data T) bool
+	Response CallbackDataResponse[T]
+}
+
+func NewCallbackData[T any](codec *callbackdata.Codec[T], r CallbackDataResponse[T]) CallbackData[T] {
+	return CallbackData[T]{
+		Codec:    codec,
+		Response: r,
+	}
+}
+
+// SetAllowChannel Enables channel messages for this handler.
+func (cb CallbackData[T]) SetAllowChannel(allow bool) CallbackData[T] {
+	cb.AllowChannel = allow
+	return cb
+}
+
+// SetFilter sets the filter applied to the decoded callback data.
+func (cb CallbackData[T]) SetFilter(filter func(data T) bool) CallbackData[T] {
+	cb.Filter = filter
+	return cb
+}
+
+func (cb CallbackData[T]) HandleUpdate(b *gotgbot.Bot, ctx *ext.Context) error {
+	data, err := cb.Codec.Decode(ctx.CallbackQuery.Data)
+	if err != nil {
+		return fmt.Errorf("failed to decode callback data: %w", err)
+	}
+	return cb.Response(b, ctx, data)
+}
+
+func (cb CallbackData[T]) CheckUpdate(b *gotgbot.Bot, ctx *ext.Context) bool {
+	if ctx.CallbackQuery == nil {
+		return false
+	}
+
+	if !cb.AllowChannel && ctx.CallbackQuery.Message != nil && ctx.CallbackQuery.Message.GetChat().Type == gotgbot.ChatTypeChannel {
+		return false
+	}
+
+	if !cb.Codec.Match(ctx.CallbackQuery.Data) {
+		return false
+	}
+
+	if cb.Filter == nil {
+		return true
+	}
+
+	data, err := cb.Codec.Decode(ctx.CallbackQuery.Data)
+	return err == nil && cb.Filter(data)
+}
+
+func (cb CallbackData[T]) Name() string {
+	return fmt.Sprintf("callback_data_handler_%p", cb.Response)
+}
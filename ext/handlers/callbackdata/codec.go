@@ -0,0 +1,359 @@
+// Package callbackdata encodes typed structs into compact callback query data, and decodes them back.
+//
+// Each struct field is encoded in order, after a prefix identifying the type of payload:
+//
+//	type Vote struct {
+//		PollId int64
+//		Option string
+//	}
+//
+//	votes, err := callbackdata.New[Vote]("vote", nil)
+//	data, err := votes.Encode(Vote{PollId: 1234, Option: "yes"}) // "vote:ya:yes"
+//
+// The payload can optionally be signed with an HMAC, to avoid clients tampering with the data. Since callback data is
+// limited to 64 bytes, payloads which are too large can be stored server-side instead, by setting a Store; the
+// callback data then only contains a short, random, ID.
+package callbackdata
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/filters"
+)
+
+var (
+	ErrInvalidPrefix    = errors.New("invalid prefix")
+	ErrUnsupportedType  = errors.New("unsupported type")
+	ErrPrefixMismatch   = errors.New("callback data prefix does not match")
+	ErrInvalidData      = errors.New("invalid callback data")
+	ErrInvalidSignature = errors.New("invalid callback data signature")
+	ErrDataTooLong      = errors.New("callback data too long")
+)
+
+// MaxDataLength is the maximum length of callback data allowed by telegram, in bytes.
+const MaxDataLength = 64
+
+const (
+	// fieldSeparator separates the prefix, each field, and the signature.
+	fieldSeparator = ":"
+	// overflowSeparator separates the prefix from the ID of a payload stored server-side.
+	overflowSeparator = "!"
+	// versionSeparator separates the prefix from the version.
+	versionSeparator = "."
+
+	// defaultSignatureLength is the default number of HMAC bytes kept in the signature.
+	defaultSignatureLength = 6
+	// overflowIdLength is the number of random bytes used to generate the ID of payloads stored server-side.
+	overflowIdLength = 9
+)
+
+// fieldEscaper escapes the characters which would otherwise be confused with separators in string fields.
+var fieldEscaper = strings.NewReplacer("%", "%25", fieldSeparator, "%3A")
+
+// Codec encodes and decodes callback data for the struct type T. Only exported fields are encoded; fields can be
+// skipped with a `callbackdata:"-"` tag.
+// Supported field types are strings, booleans, integers and floats.
+//
+// Since fields are encoded by position, changing the struct makes previously sent callback data unreadable; the
+// version should be increased whenever that happens.
+type Codec[T any] struct {
+	// header is the prefix and version which start all callback data.
+	header string
+	// signingKey is the key used to sign callback data. If empty, callback data is not signed.
+	signingKey []byte
+	// signatureLength is the number of HMAC bytes kept in the signature.
+	signatureLength int
+	// store is where payloads which are too long are stored. If nil, such payloads cannot be encoded.
+	store Store
+	// fields contains the indexes of the struct fields to encode, in order.
+	fields []int
+}
+
+// CodecOpts defines the optional parameters for the New function.
+type CodecOpts struct {
+	// Version is added to the prefix, such that callback data encoded with an older struct layout is not decoded as
+	// the current one. If 0, no version is added.
+	Version int
+	// SigningKey is the secret key used to sign callback data with an HMAC, to detect tampering. If empty, callback
+	// data is not signed.
+	SigningKey []byte
+	// SignatureLength is the number of bytes of the HMAC to keep in the signature. Defaults to 6, which adds 9
+	// characters to the callback data.
+	SignatureLength int
+	// Store is used to store payloads which don't fit in the callback data. If nil, encoding such payloads fails with
+	// ErrDataTooLong.
+	Store Store
+}
+
+// New creates a Codec for the struct type T. The prefix identifies the payload type, and must not contain any
+// ":", "!" or "." characters.
+func New[T any](prefix string, opts *CodecOpts) (*Codec[T], error) {
+	if prefix == "" || strings.ContainsAny(prefix, fieldSeparator+overflowSeparator+versionSeparator) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidPrefix, prefix)
+	}
+
+	fields, err := structFields(reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Codec[T]{
+		header:          prefix,
+		signatureLength: defaultSignatureLength,
+		fields:          fields,
+	}
+
+	if opts != nil {
+		if opts.Version != 0 {
+			c.header += versionSeparator + strconv.Itoa(opts.Version)
+		}
+		if opts.SignatureLength > 0 {
+			c.signatureLength = opts.SignatureLength
+		}
+		c.signingKey = opts.SigningKey
+		c.store = opts.Store
+	}
+
+	return c, nil
+}
+
+// structFields returns the indexes of the fields to encode, checking that they all have supported types.
+func structFields(t reflect.Type) ([]int, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %s is not a struct", ErrUnsupportedType, t)
+	}
+
+	var fields []int
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() || f.Tag.Get("callbackdata") == "-" {
+			continue
+		}
+
+		//exhaustive:ignore
+		switch f.Type.Kind() {
+		case reflect.String, reflect.Bool,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			fields = append(fields, i)
+		default:
+			return nil, fmt.Errorf("%w: field %s of type %s", ErrUnsupportedType, f.Name, f.Type)
+		}
+	}
+	return fields, nil
+}
+
+// Encode encodes the value into callback data. If the encoded value is longer than MaxDataLength, it is saved to the
+// Store, and the callback data only contains its ID.
+func (c *Codec[T]) Encode(v T) (string, error) {
+	payload := c.encodeFields(reflect.ValueOf(v))
+
+	data := c.sign(c.header + fieldSeparator + payload)
+	if len(data) <= MaxDataLength {
+		return data, nil
+	}
+
+	if c.store == nil {
+		return "", fmt.Errorf("%w: %d bytes", ErrDataTooLong, len(data))
+	}
+
+	id, err := newOverflowId()
+	if err != nil {
+		return "", err
+	}
+
+	data = c.header + overflowSeparator + id
+	if len(data) > MaxDataLength {
+		return "", fmt.Errorf("%w: %d bytes, even when stored", ErrDataTooLong, len(data))
+	}
+
+	if err := c.store.Set(data, payload); err != nil {
+		return "", fmt.Errorf("failed to store callback data: %w", err)
+	}
+	return data, nil
+}
+
+// encodeFields encodes all the struct fields, separated by fieldSeparator.
+func (c *Codec[T]) encodeFields(v reflect.Value) string {
+	values := make([]string, 0, len(c.fields))
+	for _, idx := range c.fields {
+		f := v.Field(idx)
+
+		//exhaustive:ignore
+		switch f.Kind() {
+		case reflect.String:
+			values = append(values, fieldEscaper.Replace(f.String()))
+		case reflect.Bool:
+			if f.Bool() {
+				values = append(values, "1")
+			} else {
+				values = append(values, "")
+			}
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			values = append(values, strconv.FormatInt(f.Int(), 36))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			values = append(values, strconv.FormatUint(f.Uint(), 36))
+		case reflect.Float32, reflect.Float64:
+			values = append(values, strconv.FormatFloat(f.Float(), 'g', -1, f.Type().Bits()))
+		default:
+			// Unreachable; types are checked when creating the codec.
+			values = append(values, "")
+		}
+	}
+	return strings.Join(values, fieldSeparator)
+}
+
+// sign appends the signature to the data, if a signing key is set.
+func (c *Codec[T]) sign(data string) string {
+	if len(c.signingKey) == 0 {
+		return data
+	}
+	return data + fieldSeparator + c.signature(data)
+}
+
+// signature computes the truncated HMAC of the data.
+func (c *Codec[T]) signature(data string) string {
+	mac := hmac.New(sha256.New, c.signingKey)
+	_, _ = mac.Write([]byte(data))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:c.signatureLength])
+}
+
+// newOverflowId generates a random ID to store an overflowing payload.
+func newOverflowId() (string, error) {
+	bs := make([]byte, overflowIdLength)
+	if _, err := rand.Read(bs); err != nil {
+		return "", fmt.Errorf("failed to generate callback data ID: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(bs), nil
+}
+
+// Match returns true if the callback data was encoded by a codec with the same prefix and version. This does not
+// check that the data can be decoded.
+func (c *Codec[T]) Match(data string) bool {
+	rest := strings.TrimPrefix(data, c.header)
+	return len(rest) < len(data) &&
+		(strings.HasPrefix(rest, fieldSeparator) || strings.HasPrefix(rest, overflowSeparator))
+}
+
+// Filter returns a callback query filter which matches callback data with the same prefix and version.
+func (c *Codec[T]) Filter() filters.CallbackQuery {
+	return func(cq *gotgbot.CallbackQuery) bool {
+		return c.Match(cq.Data)
+	}
+}
+
+// Decode decodes callback data which was encoded by this codec.
+func (c *Codec[T]) Decode(data string) (T, error) {
+	var v T
+	if !c.Match(data) {
+		return v, fmt.Errorf("%w: expected %q, got %q", ErrPrefixMismatch, c.header, data)
+	}
+
+	var payload string
+	if rest := data[len(c.header):]; strings.HasPrefix(rest, overflowSeparator) {
+		if c.store == nil {
+			return v, fmt.Errorf("%w: no store to load the payload from", ErrInvalidData)
+		}
+
+		var err error
+		payload, err = c.store.Get(data)
+		if err != nil {
+			return v, fmt.Errorf("failed to load callback data: %w", err)
+		}
+	} else {
+		var err error
+		payload, err = c.verify(data)
+		if err != nil {
+			return v, err
+		}
+	}
+
+	if err := c.decodeFields(reflect.ValueOf(&v).Elem(), payload); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+// verify checks the signature of the data, if a signing key is set, and returns the encoded fields.
+func (c *Codec[T]) verify(data string) (string, error) {
+	if len(c.signingKey) > 0 {
+		idx := strings.LastIndex(data, fieldSeparator)
+		if idx <= len(c.header) {
+			return "", ErrInvalidSignature
+		}
+
+		if !hmac.Equal([]byte(data[idx+1:]), []byte(c.signature(data[:idx]))) {
+			return "", ErrInvalidSignature
+		}
+		data = data[:idx]
+	}
+
+	return data[len(c.header)+len(fieldSeparator):], nil
+}
+
+// decodeFields decodes the fields of the payload into the struct.
+func (c *Codec[T]) decodeFields(v reflect.Value, payload string) error {
+	var values []string
+	if len(c.fields) > 0 {
+		values = strings.Split(payload, fieldSeparator)
+	} else if payload != "" {
+		return fmt.Errorf("%w: expected no fields, got %q", ErrInvalidData, payload)
+	}
+	if len(values) != len(c.fields) {
+		return fmt.Errorf("%w: expected %d fields, got %d", ErrInvalidData, len(c.fields), len(values))
+	}
+
+	for i, idx := range c.fields {
+		f := v.Field(idx)
+		s := values[i]
+
+		//exhaustive:ignore
+		switch f.Kind() {
+		case reflect.String:
+			unescaped, err := url.PathUnescape(s)
+			if err != nil {
+				return fmt.Errorf("%w: field %d: %v", ErrInvalidData, i, err)
+			}
+			f.SetString(unescaped)
+
+		case reflect.Bool:
+			if s != "" && s != "1" {
+				return fmt.Errorf("%w: field %d: invalid boolean %q", ErrInvalidData, i, s)
+			}
+			f.SetBool(s == "1")
+
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n, err := strconv.ParseInt(s, 36, f.Type().Bits())
+			if err != nil {
+				return fmt.Errorf("%w: field %d: %v", ErrInvalidData, i, err)
+			}
+			f.SetInt(n)
+
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			n, err := strconv.ParseUint(s, 36, f.Type().Bits())
+			if err != nil {
+				return fmt.Errorf("%w: field %d: %v", ErrInvalidData, i, err)
+			}
+			f.SetUint(n)
+
+		case reflect.Float32, reflect.Float64:
+			n, err := strconv.ParseFloat(s, f.Type().Bits())
+			if err != nil {
+				return fmt.Errorf("%w: field %d: %v", ErrInvalidData, i, err)
+			}
+			f.SetFloat(n)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package callbackdata_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/callbackdata"
+)
+
+type vote struct {
+	PollId   int64
+	Option   string
+	Retract  bool
+	Weight   float64
+	Priority uint8
+	Ignored  string `callbackdata:"-"`
+	private  string
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	for name, opts := range map[string]*callbackdata.CodecOpts{
+		"default": nil,
+		"version": {Version: 2},
+		"signed":  {SigningKey: []byte("secret")},
+	} {
+		opts := opts
+		t.Run(name, func(t *testing.T) {
+			c, err := callbackdata.New[vote]("vote", opts)
+			if err != nil {
+				t.Fatalf("failed to create codec: %v", err)
+			}
+
+			for _, v := range []vote{
+				{},
+				{PollId: -1234, Option: "yes: 100%", Retract: true, Weight: 0.5, Priority: 255},
+			} {
+				data, err := c.Encode(v)
+				if err != nil {
+					t.Fatalf("failed to encode %+v: %v", v, err)
+				}
+				if len(data) > callbackdata.MaxDataLength {
+					t.Fatalf("encoded data is too long: %q", data)
+				}
+				if !c.Match(data) {
+					t.Fatalf("expected codec to match its own data %q", data)
+				}
+
+				got, err := c.Decode(data)
+				if err != nil {
+					t.Fatalf("failed to decode %q: %v", data, err)
+				}
+				if got != v {
+					t.Fatalf("expected %+v, got %+v", v, got)
+				}
+			}
+		})
+	}
+}
+
+func TestCodecFormat(t *testing.T) {
+	c, err := callbackdata.New[vote]("vote", nil)
+	if err != nil {
+		t.Fatalf("failed to create codec: %v", err)
+	}
+
+	data, err := c.Encode(vote{PollId: 1234, Option: "a:b", Retract: true, Weight: 1.5, Priority: 10, Ignored: "x", private: "y"})
+	if err != nil {
+		t.Fatalf("failed to encode: %v", err)
+	}
+	if expected := "vote:ya:a%3Ab:1:1.5:a"; data != expected {
+		t.Fatalf("expected %q, got %q", expected, data)
+	}
+}
+
+func TestCodecPrefixMismatch(t *testing.T) {
+	v1, err := callbackdata.New[vote]("vote", nil)
+	if err != nil {
+		t.Fatalf("failed to create codec: %v", err)
+	}
+	v2, err := callbackdata.New[vote]("vote", &callbackdata.CodecOpts{Version: 2})
+	if err != nil {
+		t.Fatalf("failed to create codec: %v", err)
+	}
+
+	data, err := v1.Encode(vote{PollId: 1})
+	if err != nil {
+		t.Fatalf("failed to encode: %v", err)
+	}
+
+	if v2.Match(data) || v2.Filter()(&gotgbot.CallbackQuery{Data: data}) {
+		t.Errorf("expected version 2 to not match version 1 data %q", data)
+	}
+	if _, err := v2.Decode(data); !errors.Is(err, callbackdata.ErrPrefixMismatch) {
+		t.Errorf("expected ErrPrefixMismatch, got %v", err)
+	}
+	if v1.Match("voter:1") {
+		t.Errorf("expected prefix to not match a longer prefix")
+	}
+}
+
+func TestCodecSignature(t *testing.T) {
+	c, err := callbackdata.New[vote]("vote", &callbackdata.CodecOpts{SigningKey: []byte("secret")})
+	if err != nil {
+		t.Fatalf("failed to create codec: %v", err)
+	}
+	other, err := callbackdata.New[vote]("vote", &callbackdata.CodecOpts{SigningKey: []byte("other")})
+	if err != nil {
+		t.Fatalf("failed to create codec: %v", err)
+	}
+
+	data, err := c.Encode(vote{PollId: 1, Option: "yes"})
+	if err != nil {
+		t.Fatalf("failed to encode: %v", err)
+	}
+
+	for name, tampered := range map[string]string{
+		"changed field":   strings.Replace(data, ":yes:", ":no:", 1),
+		"other key":       mustEncode(t, other, vote{PollId: 1, Option: "yes"}),
+		"missing":         data[:strings.LastIndex(data, ":")],
+		"unsigned format": "vote:1:yes:::0",
+	} {
+		if _, err := c.Decode(tampered); !errors.Is(err, callbackdata.ErrInvalidSignature) {
+			t.Errorf("%s: expected ErrInvalidSignature for %q, got %v", name, tampered, err)
+		}
+	}
+}
+
+func TestCodecInvalidData(t *testing.T) {
+	c, err := callbackdata.New[vote]("vote", nil)
+	if err != nil {
+		t.Fatalf("failed to create codec: %v", err)
+	}
+
+	for _, data := range []string{
+		"vote:1",
+		"vote:1:yes::0:0:extra",
+		"vote:!!:yes::0:0",
+		"vote:1:yes:true:0:0",
+		"vote:1:yes::0:zzz",
+	} {
+		if _, err := c.Decode(data); !errors.Is(err, callbackdata.ErrInvalidData) {
+			t.Errorf("expected ErrInvalidData for %q, got %v", data, err)
+		}
+	}
+}
+
+func TestCodecOverflow(t *testing.T) {
+	long := vote{PollId: 1, Option: strings.Repeat("a", 100)}
+
+	c, err := callbackdata.New[vote]("vote", nil)
+	if err != nil {
+		t.Fatalf("failed to create codec: %v", err)
+	}
+	if _, err := c.Encode(long); !errors.Is(err, callbackdata.ErrDataTooLong) {
+		t.Fatalf("expected ErrDataTooLong without a store, got %v", err)
+	}
+
+	store := callbackdata.NewInMemoryStore()
+	c, err = callbackdata.New[vote]("vote", &callbackdata.CodecOpts{Store: store, SigningKey: []byte("secret")})
+	if err != nil {
+		t.Fatalf("failed to create codec: %v", err)
+	}
+
+	data, err := c.Encode(long)
+	if err != nil {
+		t.Fatalf("failed to encode: %v", err)
+	}
+	if len(data) > callbackdata.MaxDataLength || !strings.HasPrefix(data, "vote!") {
+		t.Fatalf("expected short overflow data, got %q", data)
+	}
+
+	got, err := c.Decode(data)
+	if err != nil {
+		t.Fatalf("failed to decode: %v", err)
+	}
+	if got != long {
+		t.Fatalf("expected %+v, got %+v", long, got)
+	}
+
+	if _, err := c.Decode("vote!unknown"); !errors.Is(err, callbackdata.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for unknown IDs, got %v", err)
+	}
+}
+
+func TestNewCodecErrors(t *testing.T) {
+	for _, prefix := range []string{"", "a:b", "a!b", "a.b"} {
+		if _, err := callbackdata.New[vote](prefix, nil); !errors.Is(err, callbackdata.ErrInvalidPrefix) {
+			t.Errorf("expected ErrInvalidPrefix for %q, got %v", prefix, err)
+		}
+	}
+
+	if _, err := callbackdata.New[string]("str", nil); !errors.Is(err, callbackdata.ErrUnsupportedType) {
+		t.Errorf("expected ErrUnsupportedType for non-struct types, got %v", err)
+	}
+	if _, err := callbackdata.New[struct{ Ids []int64 }]("ids", nil); !errors.Is(err, callbackdata.ErrUnsupportedType) {
+		t.Errorf("expected ErrUnsupportedType for slice fields, got %v", err)
+	}
+}
+
+func mustEncode[T any](t *testing.T, c *callbackdata.Codec[T], v T) string {
+	t.Helper()
+
+	data, err := c.Encode(v)
+	if err != nil {
+		t.Fatalf("failed to encode: %v", err)
+	}
+	return data
+}
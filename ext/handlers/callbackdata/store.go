@@ -0,0 +1,55 @@
+package callbackdata
+
+import (
+	"errors"
+	"sync"
+)
+
+var ErrNotFound = errors.New("callback data not found")
+
+// Store persists callback data payloads which are too long to be sent to telegram.
+type Store interface {
+	// Get returns the payload stored for the callback data, or ErrNotFound if there is none.
+	Get(data string) (string, error)
+	// Set stores the payload for the callback data.
+	Set(data string, payload string) error
+}
+
+// Ensure the default store implements the Store interface.
+var _ Store = &InMemoryStore{}
+
+// InMemoryStore is a thread-safe in-memory implementation of the Store interface.
+// Payloads are never evicted; bots with long uptimes sending many large payloads may want to use a store with some
+// expiry mechanism instead.
+type InMemoryStore struct {
+	// payloads maps callback data to the stored payload.
+	payloads map[string]string
+	// lock allows us to ensure synchronous data access.
+	lock sync.RWMutex
+}
+
+// NewInMemoryStore creates a new, empty, InMemoryStore.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		payloads: map[string]string{},
+	}
+}
+
+func (s *InMemoryStore) Get(data string) (string, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	payload, ok := s.payloads[data]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return payload, nil
+}
+
+func (s *InMemoryStore) Set(data string, payload string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.payloads[data] = payload
+	return nil
+}
@@ -0,0 +1,83 @@
+package handlers_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/callbackdata"
+)
+
+type pageData struct {
+	Menu string
+	Page int
+}
+
+func TestCallbackData(t *testing.T) {
+	b := NewTestBot()
+
+	codec, err := callbackdata.New[pageData]("page", &callbackdata.CodecOpts{SigningKey: []byte("secret")})
+	if err != nil {
+		t.Fatalf("failed to create codec: %v", err)
+	}
+
+	var received *pageData
+	h := handlers.NewCallbackData(codec, func(b *gotgbot.Bot, ctx *ext.Context, data pageData) error {
+		received = &data
+		return nil
+	}).SetFilter(func(data pageData) bool {
+		return data.Menu == "main"
+	})
+
+	data, err := codec.Encode(pageData{Menu: "main", Page: 3})
+	if err != nil {
+		t.Fatalf("failed to encode callback data: %v", err)
+	}
+
+	ctx := newCallbackQuery(b, data)
+	if !h.CheckUpdate(b, ctx) {
+		t.Fatalf("expected handler to match %q", data)
+	}
+	if err := h.HandleUpdate(b, ctx); err != nil {
+		t.Fatalf("failed to handle update: %v", err)
+	}
+	if received == nil || *received != (pageData{Menu: "main", Page: 3}) {
+		t.Fatalf("expected decoded callback data, got %+v", received)
+	}
+
+	other, err := codec.Encode(pageData{Menu: "settings", Page: 1})
+	if err != nil {
+		t.Fatalf("failed to encode callback data: %v", err)
+	}
+	for _, data := range []string{other, "other:main:3", "page:main:3:invalid"} {
+		if h.CheckUpdate(b, newCallbackQuery(b, data)) {
+			t.Errorf("expected handler to not match %q", data)
+		}
+	}
+
+	// Without a filter, tampered data is matched, but returns an error.
+	h.Filter = nil
+	ctx = newCallbackQuery(b, "page:main:3:invalid")
+	if !h.CheckUpdate(b, ctx) {
+		t.Fatalf("expected handler to match prefix")
+	}
+	if err := h.HandleUpdate(b, ctx); !errors.Is(err, callbackdata.ErrInvalidSignature) {
+		t.Fatalf("expected ErrInvalidSignature, got %v", err)
+	}
+}
+
+func newCallbackQuery(b *gotgbot.Bot, data string) *ext.Context {
+	return ext.NewContext(b, &gotgbot.Update{
+		CallbackQuery: &gotgbot.CallbackQuery{
+			Id:   "id",
+			From: gotgbot.User{Id: 1, FirstName: "bob"},
+			Message: gotgbot.Message{
+				MessageId: 1,
+				Chat:      gotgbot.Chat{Id: 1, Type: "private"},
+			},
+			Data: data,
+		},
+	}, nil)
+}
@@ -94,7 +94,7 @@ func (c Command) Name() string {
 
 func (c Command) checkMessage(b *gotgbot.Bot, msg *gotgbot.Message) bool {
 	ents := msg.GetEntities()
-	if len(ents) != 0 && ents[0].Offset == 0 && ents[0].Type != "bot_command" {
+	if len(ents) != 0 && ents[0].Offset == 0 && ents[0].Type != gotgbot.MessageEntityTypeBotCommand {
 		return false
 	}
 
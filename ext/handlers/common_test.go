@@ -54,9 +54,9 @@ func buildCommand(cmd string, args []string) (string, []gotgbot.MessageEntity) {
 }
 
 func newMessage(b *gotgbot.Bot, userId int64, chatId int64, message string, entities []gotgbot.MessageEntity) *ext.Context {
-	chatType := "supergroup"
+	chatType := gotgbot.ChatTypeSupergroup
 	if userId == chatId {
-		chatType = "private"
+		chatType = gotgbot.ChatTypePrivate
 	}
 
 	return ext.NewContext(b, &gotgbot.Update{
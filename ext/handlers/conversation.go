@@ -1,21 +1,31 @@
 package handlers
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"log"
+	"sync"
+	"time"
 
 	"github.com/PaulSonOfLars/gotgbot/v2"
 	"github.com/PaulSonOfLars/gotgbot/v2/ext"
 	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/conversation"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/filters"
 )
 
-// TODO: Add a "block" option to force linear processing. Also a "waiting" state to handle blocked handlers.
-// TODO: Allow for timeouts (and a "timeout" state to handle that)
-
 // ConversationFilter is much wider than regular filters, because it allows for any kind of update; we may want
 // messages, commands, callbacks, etc.
 type ConversationFilter func(ctx *ext.Context) bool
 
+// ConversationMessageFilter lifts a message filter into a ConversationFilter, which checks the effective message of
+// each update. Updates without an effective message do not match.
+func ConversationMessageFilter(f filters.Message) ConversationFilter {
+	return func(ctx *ext.Context) bool {
+		return ctx.EffectiveMessage != nil && f(ctx.EffectiveMessage)
+	}
+}
+
 // The Conversation handler is an advanced handler which allows for running a sequence of commands in a stateful manner.
 // An example of this flow can be found at t.me/Botfather; upon receiving the "/newbot" command, the user is asked for
 // the name of their bot, which is sent as a separate message.
@@ -41,6 +51,47 @@ type Conversation struct {
 	// one specific chat, or to avoid unwanted updates which may interfere with the conversation key strategy
 	// (eg polls).
 	Filter ConversationFilter
+	// Timeout is the duration after which an idle conversation expires. Once expired, the conversation is ended, and
+	// the TimeoutHandlers are called. If 0, conversations never expire.
+	//
+	// Expired conversations are detected by a background timer. Conversations started before a restart (when using
+	// persistent storage) are only detected as expired when their next update comes in.
+	Timeout time.Duration
+	// TimeoutHandlers is the list of handlers to call when a conversation expires; the first matching handler is
+	// run, using the last update received by the conversation. This can be used to notify users that they took too
+	// long to reply. The conversation is always ended, whatever the handler returns.
+	TimeoutHandlers []ext.Handler
+	// UnhandledErrFunc handles any errors returned by TimeoutHandlers run from the background timer, since these
+	// cannot be returned to the dispatcher.
+	// If nil, the error is logged via the log package's standard logger.
+	UnhandledErrFunc ext.ErrorFunc
+	// Blocking forces the updates of each conversation to be processed one at a time, in a linear manner.
+	// Since the dispatcher processes updates concurrently, this avoids race conditions when a user sends multiple
+	// updates in quick succession, which could otherwise cause lost state transitions.
+	// Updates are blocked until the previous update of the same conversation has been handled, unless they match one
+	// of the WaitingHandlers.
+	//
+	// Conversations are identified by the StateStorage's key (see conversation.KeyedStorage). If the storage does not
+	// expose its keys, conversation.KeyStrategySenderAndChat is used.
+	// Note: This requires the conversation to be created with NewConversation.
+	//
+	// Blocked updates wait inside the dispatcher's goroutine, so they keep using up one of the dispatcher's
+	// MaxRoutines until their turn comes. A single user flooding a slow conversation can therefore starve the
+	// dispatcher; WaitingHandlers avoid this, by answering the extra updates straight away instead.
+	// Blocked updates stop waiting, and return the context error, once their context is cancelled (eg, when the
+	// dispatcher's StopWithContext deadline has passed).
+	Blocking bool
+	// WaitingHandlers is the list of handlers to handle updates which arrive while a previous update of the same
+	// conversation is still being processed; for example, to tell the user to wait. These handlers cannot change the
+	// conversation state. If no waiting handler matches, the update waits for its turn.
+	// Only used if Blocking is true.
+	WaitingHandlers []ext.Handler
+
+	// timeouts keeps track of the background timers used to expire conversations.
+	// If nil (eg, the struct was not created with NewConversation), conversations only expire on their next update.
+	timeouts *conversationTimeouts
+	// locks keeps track of the conversations currently being processed, when Blocking is enabled.
+	locks *conversationLocks
 }
 
 type ConversationOpts struct {
@@ -57,6 +108,22 @@ type ConversationOpts struct {
 	// one specific chat, or to avoid unwanted updates which may interfere with the conversation key strategy
 	// (eg polls).
 	Filter ConversationFilter
+	// Timeout is the duration after which an idle conversation expires. If 0, conversations never expire.
+	// More info at Conversation.Timeout.
+	Timeout time.Duration
+	// TimeoutHandlers is the list of handlers to call when a conversation expires.
+	// More info at Conversation.TimeoutHandlers.
+	TimeoutHandlers []ext.Handler
+	// UnhandledErrFunc handles any errors returned by TimeoutHandlers run from the background timer.
+	// If nil, the error is logged via the log package's standard logger.
+	UnhandledErrFunc ext.ErrorFunc
+	// Blocking forces the updates of each conversation to be processed one at a time.
+	// More info at Conversation.Blocking.
+	Blocking bool
+	// WaitingHandlers is the list of handlers to handle updates which arrive while a previous update of the same
+	// conversation is still being processed.
+	// More info at Conversation.WaitingHandlers.
+	WaitingHandlers []ext.Handler
 }
 
 func NewConversation(entryPoints []ext.Handler, states map[string][]ext.Handler, opts *ConversationOpts) Conversation {
@@ -65,6 +132,8 @@ func NewConversation(entryPoints []ext.Handler, states map[string][]ext.Handler,
 		States:      states,
 		// Setup a default storage medium
 		StateStorage: conversation.NewInMemoryStorage(conversation.KeyStrategySenderAndChat),
+		timeouts:     &conversationTimeouts{},
+		locks:        &conversationLocks{},
 	}
 
 	if opts != nil {
@@ -72,6 +141,11 @@ func NewConversation(entryPoints []ext.Handler, states map[string][]ext.Handler,
 		c.Fallbacks = opts.Fallbacks
 		c.AllowReEntry = opts.AllowReEntry
 		c.Filter = opts.Filter
+		c.Timeout = opts.Timeout
+		c.TimeoutHandlers = opts.TimeoutHandlers
+		c.UnhandledErrFunc = opts.UnhandledErrFunc
+		c.Blocking = opts.Blocking
+		c.WaitingHandlers = opts.WaitingHandlers
 
 		// If no StateStorage is specified, we should keep the default.
 		if opts.StateStorage != nil {
@@ -83,17 +157,48 @@ func NewConversation(entryPoints []ext.Handler, states map[string][]ext.Handler,
 }
 
 func (c Conversation) CheckUpdate(b *gotgbot.Bot, ctx *ext.Context) bool {
+	if c.isBlocking() && (c.Filter == nil || c.Filter(ctx)) {
+		if key, err := c.key(ctx); err == nil && c.locks.isLocked(key) {
+			// The conversation is busy, so we can't know which handler to use until the previous update has been
+			// handled. Accept it for now; HandleUpdate will either wait for its turn, or use the waiting handlers.
+			return true
+		}
+	}
+
 	// Note: Kinda sad that this error gets lost.
-	h, _ := c.getNextHandler(b, ctx)
+	h, _, _ := c.getNextHandler(b, ctx)
 	return h != nil
 }
 
 func (c Conversation) HandleUpdate(b *gotgbot.Bot, ctx *ext.Context) error {
-	next, err := c.getNextHandler(b, ctx)
+	if c.isBlocking() {
+		key, err := c.key(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get conversation key: %w", err)
+		}
+
+		if !c.locks.tryLock(key) {
+			// A previous update is still being processed; use the waiting handlers if possible.
+			if next := checkHandlerList(c.WaitingHandlers, b, ctx); next != nil {
+				return ignoreStateChanges(next.HandleUpdate(b, ctx))
+			}
+			if err := c.locks.lock(ctx, key); err != nil {
+				return fmt.Errorf("failed to wait for conversation: %w", err)
+			}
+		}
+		defer c.locks.unlock(key)
+	}
+
+	next, currState, err := c.getNextHandler(b, ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get next handler in conversation: %w", err)
 	}
 	if next == nil {
+		if c.isBlocking() {
+			// The conversation state changed while this update was waiting, and it no longer matches; let the
+			// dispatcher try the other handlers in this group.
+			return ext.ContinueGroups
+		}
 		// Note: this should be impossible
 		return nil
 	}
@@ -101,6 +206,12 @@ func (c Conversation) HandleUpdate(b *gotgbot.Bot, ctx *ext.Context) error {
 	var stateChange *ConversationStateChange
 	err = next.HandleUpdate(b, ctx)
 	if !errors.As(err, &stateChange) {
+		if currState != nil {
+			// The conversation is still ongoing; make sure to record the activity.
+			if err := c.touch(b, ctx, *currState); err != nil {
+				return err
+			}
+		}
 		// We don't wrap this error, as users might want to handle it explicitly
 		return err
 	}
@@ -111,6 +222,7 @@ func (c Conversation) HandleUpdate(b *gotgbot.Bot, ctx *ext.Context) error {
 		if err != nil {
 			return fmt.Errorf("failed to end conversation: %w", err)
 		}
+		c.stopTimeout(ctx)
 	}
 
 	if stateChange.NextState != nil {
@@ -119,9 +231,14 @@ func (c Conversation) HandleUpdate(b *gotgbot.Bot, ctx *ext.Context) error {
 			// Check if the "next" state is a supported state.
 			return fmt.Errorf("unknown state: %w", stateChange)
 		}
-		err := c.StateStorage.Set(ctx, conversation.State{Key: *stateChange.NextState})
+		err := c.setState(b, ctx, conversation.State{Key: *stateChange.NextState})
 		if err != nil {
-			return fmt.Errorf("failed to update conversation state: %w", err)
+			return err
+		}
+	} else if !stateChange.End && currState != nil {
+		// The state hasn't changed, but the conversation is still ongoing; make sure to record the activity.
+		if err := c.touch(b, ctx, *currState); err != nil {
+			return err
 		}
 	}
 
@@ -133,6 +250,124 @@ func (c Conversation) HandleUpdate(b *gotgbot.Bot, ctx *ext.Context) error {
 	return nil
 }
 
+// setState stores the new conversation state, marking the conversation as active.
+func (c Conversation) setState(b *gotgbot.Bot, ctx *ext.Context, state conversation.State) error {
+	state.LastActivity = time.Now()
+	err := c.StateStorage.Set(ctx, state)
+	if err != nil {
+		return fmt.Errorf("failed to update conversation state: %w", err)
+	}
+
+	if c.Timeout > 0 {
+		if key, err := c.key(ctx); err == nil {
+			c.timeouts.reset(key, c.Timeout, func() {
+				c.expire(b, ctx)
+			})
+		}
+	}
+	return nil
+}
+
+// isBlocking returns true if updates should be processed one at a time.
+func (c Conversation) isBlocking() bool {
+	return c.Blocking && c.locks != nil
+}
+
+// key returns the key identifying the conversation the update belongs to.
+func (c Conversation) key(ctx *ext.Context) (string, error) {
+	if s, ok := c.StateStorage.(conversation.KeyedStorage); ok {
+		return s.Key(ctx)
+	}
+	return conversation.KeyStrategySenderAndChat(ctx)
+}
+
+// stopTimeout cancels the expiry timer of the conversation, if any.
+func (c Conversation) stopTimeout(ctx *ext.Context) {
+	if key, err := c.key(ctx); err == nil {
+		c.timeouts.stop(key)
+	}
+}
+
+// touch marks an ongoing conversation as active. This is only needed when conversations can time out.
+func (c Conversation) touch(b *gotgbot.Bot, ctx *ext.Context, state conversation.State) error {
+	if c.Timeout <= 0 {
+		return nil
+	}
+	return c.setState(b, ctx, state)
+}
+
+// isExpired checks whether a conversation state has been idle for longer than the conversation timeout.
+// States without any recorded activity (eg, those stored by older versions) never expire.
+func (c Conversation) isExpired(state *conversation.State) bool {
+	return c.Timeout > 0 && !state.LastActivity.IsZero() && time.Since(state.LastActivity) >= c.Timeout
+}
+
+// expire is called by the background timer once a conversation may have expired. If it has, the conversation is
+// ended and the timeout handlers are run.
+func (c Conversation) expire(b *gotgbot.Bot, ctx *ext.Context) {
+	// The context of the original update has been cancelled once it was processed, so the timeout gets a new one;
+	// this is still cancelled when the dispatcher is stopped.
+	timeoutCtx := *ctx
+	timeoutCtx.Context = ctx.BackgroundContext()
+	if timeoutCtx.Err() != nil {
+		// The dispatcher has been stopped; the conversation will be expired on its next update instead.
+		return
+	}
+
+	err := c.endExpired(b, &timeoutCtx)
+	if err == nil {
+		return
+	}
+
+	if c.UnhandledErrFunc != nil {
+		c.UnhandledErrFunc(err)
+	} else {
+		log.Printf("Failed to expire conversation: %s", err.Error())
+	}
+}
+
+func (c Conversation) endExpired(b *gotgbot.Bot, ctx *ext.Context) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ext.ErrPanicRecovered, r)
+		}
+	}()
+
+	key, err := c.key(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get conversation key: %w", err)
+	}
+
+	if c.isBlocking() {
+		// Avoid expiring a conversation while one of its updates is being processed.
+		if err := c.locks.lock(ctx, key); err != nil {
+			return fmt.Errorf("failed to wait for conversation: %w", err)
+		}
+		defer c.locks.unlock(key)
+	}
+
+	state, err := c.StateStorage.Get(ctx)
+	if err != nil {
+		if errors.Is(err, conversation.ErrKeyNotFound) {
+			// Conversation has already ended; nothing to do.
+			return nil
+		}
+		return fmt.Errorf("failed to get state from conversation storage: %w", err)
+	}
+
+	if !c.isExpired(state) {
+		if !state.LastActivity.IsZero() {
+			// The conversation was active more recently than this timer knew about; check again later.
+			c.timeouts.reset(key, c.Timeout-time.Since(state.LastActivity), func() {
+				c.expire(b, ctx)
+			})
+		}
+		return nil
+	}
+
+	return wrappedTimeoutHandler{c: c, h: checkHandlerList(c.TimeoutHandlers, b, ctx)}.HandleUpdate(b, ctx)
+}
+
 // ConversationStateChange handles all the possible states that can be returned from a conversation.
 type ConversationStateChange struct {
 	// The next state to handle in the current conversation.
@@ -181,11 +416,12 @@ func (c Conversation) Name() string {
 
 // getNextHandler goes through all the handlers in the conversation, until it finds a handler that matches.
 // If no matching handler is found, returns nil.
-func (c Conversation) getNextHandler(b *gotgbot.Bot, ctx *ext.Context) (ext.Handler, error) {
+// The current state of the conversation is also returned, if the conversation is ongoing.
+func (c Conversation) getNextHandler(b *gotgbot.Bot, ctx *ext.Context) (ext.Handler, *conversation.State, error) {
 	// If the user has defined a filter, and this filter does NOT return true, then we do NOT want to consider this
 	// update for the conversation.
 	if c.Filter != nil && !c.Filter(ctx) {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	// Check if a conversation has already started for this user.
@@ -194,35 +430,55 @@ func (c Conversation) getNextHandler(b *gotgbot.Bot, ctx *ext.Context) (ext.Hand
 		if errors.Is(err, conversation.ErrKeyNotFound) {
 			// If this is an unknown conversation key, then we know this is a new conversation, so we check all
 			// entrypoints.
-			return checkHandlerList(c.EntryPoints, b, ctx), nil
+			return checkHandlerList(c.EntryPoints, b, ctx), nil, nil
 		}
 		// Else, we need to handle the error.
-		return nil, fmt.Errorf("failed to get state from conversation storage: %w", err)
+		return nil, nil, fmt.Errorf("failed to get state from conversation storage: %w", err)
+	}
+
+	// If the conversation has expired, it should be handled as a new conversation; unless the timeout handlers
+	// haven't been run yet, in which case they take precedence over anything but entrypoints.
+	if c.isExpired(currState) {
+		if next := checkHandlerList(c.EntryPoints, b, ctx); next != nil {
+			return wrappedTimeoutHandler{c: c, h: next, restart: true}, nil, nil
+		}
+		if next := checkHandlerList(c.TimeoutHandlers, b, ctx); next != nil {
+			return wrappedTimeoutHandler{c: c, h: next}, nil, nil
+		}
+		// Nothing handles this update, but the conversation is over either way. This is also reached from
+		// CheckUpdate, which mustn't change the storage; so leave it to the timeout sweeper to end it, to make sure
+		// the state doesn't linger in the storage (eg, when the conversation expired while the bot was offline).
+		if key, err := c.key(ctx); err == nil {
+			c.timeouts.reset(key, 0, func() {
+				c.expire(b, ctx)
+			})
+		}
+		return nil, nil, nil
 	}
 
 	// If reentry is allowed, check the entrypoints again.
 	if c.AllowReEntry {
 		if next := checkHandlerList(c.EntryPoints, b, ctx); next != nil {
-			return next, nil
+			return next, currState, nil
 		}
 	}
 
 	// Else, exits -> handle any conversation exits/cancellations.
 	if next := checkHandlerList(c.Exits, b, ctx); next != nil {
-		return wrappedExitHandler{h: next}, nil
+		return wrappedExitHandler{h: next}, currState, nil
 	}
 
 	// Else, check state mappings (the magic happens here!).
 	if next := checkHandlerList(c.States[currState.Key], b, ctx); next != nil {
-		return next, nil
+		return next, currState, nil
 	}
 
 	// Else, fallbacks -> handle any updates which haven't been caught by the state or exit handlers.
 	if next := checkHandlerList(c.Fallbacks, b, ctx); next != nil {
-		return next, nil
+		return next, currState, nil
 	}
 
-	return nil, nil
+	return nil, nil, nil
 }
 
 // checkHandlerList iterates over a list of handlers until a match is found; at which point it is returned.
@@ -255,3 +511,199 @@ func (w wrappedExitHandler) HandleUpdate(b *gotgbot.Bot, ctx *ext.Context) error
 func (w wrappedExitHandler) Name() string {
 	return w.h.Name()
 }
+
+// wrappedTimeoutHandler ends expired conversations before running the handler.
+// Unless the conversation is being restarted from an entrypoint, the conversation remains ended whatever the handler
+// returns.
+type wrappedTimeoutHandler struct {
+	c Conversation
+	h ext.Handler
+	// restart is true if h is an entrypoint, starting a new conversation.
+	restart bool
+}
+
+func (w wrappedTimeoutHandler) CheckUpdate(b *gotgbot.Bot, ctx *ext.Context) bool {
+	return w.h != nil && w.h.CheckUpdate(b, ctx)
+}
+
+func (w wrappedTimeoutHandler) HandleUpdate(b *gotgbot.Bot, ctx *ext.Context) error {
+	err := w.c.StateStorage.Delete(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to end expired conversation: %w", err)
+	}
+	w.c.stopTimeout(ctx)
+
+	if w.h == nil {
+		return nil
+	}
+
+	err = w.h.HandleUpdate(b, ctx)
+	if w.restart {
+		return err
+	}
+	// The conversation has already ended; ignore any attempts to move to another state.
+	return ignoreStateChanges(err)
+}
+
+// ignoreStateChanges drops any conversation state changes returned by handlers which aren't allowed to change state.
+func ignoreStateChanges(err error) error {
+	var stateChange *ConversationStateChange
+	if errors.As(err, &stateChange) {
+		return nil
+	}
+	return err
+}
+
+func (w wrappedTimeoutHandler) Name() string {
+	if w.h == nil {
+		return "timeout"
+	}
+	return w.h.Name()
+}
+
+// conversationTimeouts keeps track of the background timers used to expire idle conversations.
+type conversationTimeouts struct {
+	// timers maps each conversation to its expiry timer.
+	timers map[string]*time.Timer
+	// mux ensures the timers map is concurrency-safe.
+	mux sync.Mutex
+}
+
+// reset (re)starts the expiry timer for the conversation.
+func (t *conversationTimeouts) reset(key string, d time.Duration, f func()) {
+	if t == nil {
+		return
+	}
+
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	if t.timers == nil {
+		t.timers = map[string]*time.Timer{}
+	}
+
+	if timer, ok := t.timers[key]; ok {
+		timer.Stop()
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(d, func() {
+		t.mux.Lock()
+		if t.timers[key] == timer {
+			delete(t.timers, key)
+		}
+		t.mux.Unlock()
+
+		f()
+	})
+	t.timers[key] = timer
+}
+
+// stop cancels the expiry timer for the conversation, if any.
+func (t *conversationTimeouts) stop(key string) {
+	if t == nil {
+		return
+	}
+
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	if timer, ok := t.timers[key]; ok {
+		timer.Stop()
+		delete(t.timers, key)
+	}
+}
+
+// conversationLocks allows for processing the updates of each conversation one at a time.
+type conversationLocks struct {
+	// locks maps each busy conversation to its lock.
+	locks map[string]*conversationLock
+	// mux ensures the locks map is concurrency-safe.
+	mux sync.Mutex
+}
+
+type conversationLock struct {
+	// sem is a semaphore of size 1; it is full while the conversation is locked.
+	sem chan struct{}
+	// refs counts the number of goroutines holding or waiting for the lock, so it can be cleaned up once unused.
+	refs int
+}
+
+// acquire returns the lock for the conversation, creating it if necessary, and marks it as in use.
+func (l *conversationLocks) acquire(key string) *conversationLock {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	if l.locks == nil {
+		l.locks = map[string]*conversationLock{}
+	}
+
+	lock, ok := l.locks[key]
+	if !ok {
+		lock = &conversationLock{sem: make(chan struct{}, 1)}
+		l.locks[key] = lock
+	}
+	lock.refs++
+	return lock
+}
+
+// release marks the lock for the conversation as no longer used, cleaning it up if possible.
+func (l *conversationLocks) release(key string) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	lock, ok := l.locks[key]
+	if !ok {
+		return
+	}
+	lock.refs--
+	if lock.refs <= 0 {
+		delete(l.locks, key)
+	}
+}
+
+// isLocked returns true if the conversation is currently being processed.
+func (l *conversationLocks) isLocked(key string) bool {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	lock, ok := l.locks[key]
+	return ok && len(lock.sem) > 0
+}
+
+// tryLock locks the conversation if it isn't currently locked. Returns true if the lock was obtained.
+func (l *conversationLocks) tryLock(key string) bool {
+	lock := l.acquire(key)
+	select {
+	case lock.sem <- struct{}{}:
+		return true
+	default:
+		l.release(key)
+		return false
+	}
+}
+
+// lock locks the conversation, waiting for any previous updates to be processed.
+// If the context is done first, the context error is returned, and the lock isn't obtained.
+func (l *conversationLocks) lock(ctx context.Context, key string) error {
+	select {
+	case l.acquire(key).sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		l.release(key)
+		return ctx.Err()
+	}
+}
+
+// unlock unlocks the conversation, allowing the next update to be processed.
+func (l *conversationLocks) unlock(key string) {
+	l.mux.Lock()
+	lock, ok := l.locks[key]
+	l.mux.Unlock()
+	if !ok {
+		return
+	}
+
+	<-lock.sem
+	l.release(key)
+}
@@ -0,0 +1,494 @@
+package conversationtest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/conversation"
+	"github.com/PaulSonOfLars/gotgbot/v2/gotgbottest"
+)
+
+// Default IDs of the user and chat sending updates in a Flow.
+const (
+	DefaultUserId = 1000
+	DefaultChatId = 1000
+)
+
+// Flow runs a handlers.Conversation through a real ext.Dispatcher, to test multi-step dialogs. Updates are sent on
+// behalf of a single user, in a single chat, and are processed synchronously; outgoing API calls are served by a
+// gotgbottest.Server.
+//
+//	f := conversationtest.NewFlow(t, conv, nil)
+//	f.Run(
+//		conversationtest.Step{Update: conversationtest.Command("start", ""), State: "name", Replies: []string{"What's your name?"}},
+//		conversationtest.Step{Update: conversationtest.Text("John"), State: "", Replies: []string{"Hello, John!"}},
+//	)
+type Flow struct {
+	// Server is the fake Bot API, which receives all the calls made by the bot.
+	Server *gotgbottest.Server
+	// Bot is the bot handling the updates.
+	Bot *gotgbot.Bot
+	// Dispatcher is the dispatcher processing the updates. Other handlers can be added to it as required.
+	Dispatcher *ext.Dispatcher
+	// Conversation is the conversation being tested, as added to the dispatcher.
+	Conversation handlers.Conversation
+	// User is the sender of all updates which don't specify a sender.
+	User gotgbot.User
+	// Chat is the chat of all updates which don't specify a chat.
+	Chat gotgbot.Chat
+
+	// t is the test the flow is running in.
+	t testing.TB
+	// lastUpdateId is the ID of the last update sent.
+	lastUpdateId int64
+	// lastMessageId is the ID of the last message sent by the user.
+	lastMessageId int64
+
+	// mux protects the fields recorded while an update is being processed.
+	mux sync.Mutex
+	// change is the last state change returned by a conversation handler.
+	change *handlers.ConversationStateChange
+	// errs contains the errors returned to the dispatcher.
+	errs []error
+}
+
+// FlowOpts defines the optional parameters for the NewFlow function.
+type FlowOpts struct {
+	// UserId is the ID of the user sending the updates. Defaults to DefaultUserId.
+	UserId int64
+	// ChatId is the ID of the chat the updates are sent in. Defaults to DefaultChatId, which is the private chat with
+	// the user.
+	ChatId int64
+	// DispatcherOpts are the options used to create the dispatcher. Any Error handler is still called, after the
+	// error has been recorded.
+	DispatcherOpts *ext.DispatcherOpts
+}
+
+// NewFlow creates a Flow for the given conversation. The Server is closed when the test completes.
+func NewFlow(t testing.TB, conv handlers.Conversation, opts *FlowOpts) *Flow {
+	t.Helper()
+
+	userId := int64(DefaultUserId)
+	chatId := int64(DefaultChatId)
+	var dispatcherOpts ext.DispatcherOpts
+	if opts != nil {
+		if opts.UserId != 0 {
+			userId = opts.UserId
+		}
+		if opts.ChatId != 0 {
+			chatId = opts.ChatId
+		}
+		if opts.DispatcherOpts != nil {
+			dispatcherOpts = *opts.DispatcherOpts
+		}
+	}
+
+	chatType := gotgbot.ChatTypeSupergroup
+	if userId == chatId {
+		chatType = gotgbot.ChatTypePrivate
+	}
+
+	s := gotgbottest.NewServer()
+	t.Cleanup(s.Close)
+
+	b, err := s.NewBot()
+	if err != nil {
+		t.Fatalf("failed to create bot: %v", err)
+	}
+
+	f := &Flow{
+		Server: s,
+		Bot:    b,
+		User:   gotgbot.User{Id: userId, FirstName: "user"},
+		Chat:   gotgbot.Chat{Id: chatId, Type: chatType},
+		t:      t,
+	}
+
+	errHandler := dispatcherOpts.Error
+	dispatcherOpts.Error = func(b *gotgbot.Bot, ctx *ext.Context, err error) ext.DispatcherAction {
+		f.mux.Lock()
+		f.errs = append(f.errs, err)
+		f.mux.Unlock()
+
+		if errHandler != nil {
+			return errHandler(b, ctx, err)
+		}
+		return ext.DispatcherActionNoop
+	}
+	f.Dispatcher = ext.NewDispatcher(&dispatcherOpts)
+
+	f.Conversation = f.record(conv)
+	f.Dispatcher.AddHandler(f.Conversation)
+	return f
+}
+
+// record wraps all the handlers of the conversation, to record the state changes they return.
+func (f *Flow) record(conv handlers.Conversation) handlers.Conversation {
+	conv.EntryPoints = f.recordAll(conv.EntryPoints, false)
+	conv.Exits = f.recordAll(conv.Exits, true)
+	conv.Fallbacks = f.recordAll(conv.Fallbacks, false)
+	conv.TimeoutHandlers = f.recordAll(conv.TimeoutHandlers, false)
+	conv.WaitingHandlers = f.recordAll(conv.WaitingHandlers, false)
+
+	states := make(map[string][]ext.Handler, len(conv.States))
+	for k, hs := range conv.States {
+		states[k] = f.recordAll(hs, false)
+	}
+	conv.States = states
+	return conv
+}
+
+// recordAll wraps a list of handlers, to record their state changes. exit should be true for the conversation's exit
+// handlers.
+func (f *Flow) recordAll(hs []ext.Handler, exit bool) []ext.Handler {
+	if hs == nil {
+		return nil
+	}
+
+	recorded := make([]ext.Handler, 0, len(hs))
+	for _, h := range hs {
+		recorded = append(recorded, recordingHandler{Handler: h, f: f, exit: exit})
+	}
+	return recorded
+}
+
+// recordingHandler records the state changes returned by a conversation handler.
+type recordingHandler struct {
+	// The conversation handler to record.
+	ext.Handler
+	// f is the flow to record the state changes to.
+	f *Flow
+	// exit is true for exit handlers, which end the conversation by default.
+	exit bool
+}
+
+func (r recordingHandler) HandleUpdate(b *gotgbot.Bot, ctx *ext.Context) error {
+	err := r.Handler.HandleUpdate(b, ctx)
+
+	var stateChange *handlers.ConversationStateChange
+	if err == nil && r.exit {
+		// Exit handlers which return nil end the conversation by default; record the change the conversation applies.
+		stateChange = &handlers.ConversationStateChange{End: true}
+	}
+	if stateChange != nil || errors.As(err, &stateChange) {
+		r.f.mux.Lock()
+		r.f.change = stateChange
+		r.f.mux.Unlock()
+	}
+	return err
+}
+
+// Text builds an update containing a text message. The sender and chat are filled in by Flow.Send.
+func Text(text string) gotgbot.Update {
+	return gotgbot.Update{Message: &gotgbot.Message{Text: text}}
+}
+
+// Command builds an update containing a command message, such as "/start args". The command is given without its
+// leading slash. The sender and chat are filled in by Flow.Send.
+func Command(command string, args string) gotgbot.Update {
+	text := "/" + command
+	if args != "" {
+		text += " " + args
+	}
+
+	return gotgbot.Update{Message: &gotgbot.Message{
+		Text: text,
+		Entities: []gotgbot.MessageEntity{{
+			Type:   gotgbot.MessageEntityTypeBotCommand,
+			Offset: 0,
+			Length: int64(len(command) + 1),
+		}},
+	}}
+}
+
+// Callback builds an update containing a callback query, as sent when pressing an inline keyboard button. The sender
+// and chat are filled in by Flow.Send.
+func Callback(data string) gotgbot.Update {
+	return gotgbot.Update{CallbackQuery: &gotgbot.CallbackQuery{Data: data}}
+}
+
+// Result describes what happened when sending an update through a Flow.
+type Result struct {
+	// Update is the update which was sent, as seen by the dispatcher.
+	Update gotgbot.Update
+	// Change is the state change returned by the conversation handler; nil if the handler did not change the state.
+	Change *handlers.ConversationStateChange
+	// Errors contains all the errors returned to the dispatcher while handling the update, excluding state changes
+	// handled by the conversation.
+	Errors []error
+	// Calls contains all the API calls made while handling the update.
+	Calls []gotgbottest.Call
+
+	// t is the test the update was sent in.
+	t testing.TB
+}
+
+// Send sends an update to the dispatcher, and waits for it to be processed. Any missing sender, chat, and IDs are
+// filled in from the flow.
+func (f *Flow) Send(upd gotgbot.Update) *Result {
+	f.t.Helper()
+
+	f.fill(&upd)
+
+	f.mux.Lock()
+	f.change = nil
+	f.errs = nil
+	f.mux.Unlock()
+
+	prevCalls := len(f.Server.Calls())
+	if err := f.Dispatcher.ProcessUpdate(f.Bot, &upd, nil); err != nil {
+		f.t.Fatalf("failed to process update %d: %v", upd.UpdateId, err)
+	}
+
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	return &Result{
+		Update: upd,
+		Change: f.change,
+		Errors: f.errs,
+		Calls:  f.Server.Calls()[prevCalls:],
+		t:      f.t,
+	}
+}
+
+// Text sends a text message; see Send.
+func (f *Flow) Text(text string) *Result {
+	f.t.Helper()
+	return f.Send(Text(text))
+}
+
+// Command sends a command message; see Send.
+func (f *Flow) Command(command string, args string) *Result {
+	f.t.Helper()
+	return f.Send(Command(command, args))
+}
+
+// Callback sends a callback query; see Send.
+func (f *Flow) Callback(data string) *Result {
+	f.t.Helper()
+	return f.Send(Callback(data))
+}
+
+// fill populates the missing fields of an update with the flow's sender, chat, and the next IDs.
+func (f *Flow) fill(upd *gotgbot.Update) {
+	if upd.UpdateId == 0 {
+		f.lastUpdateId++
+		upd.UpdateId = f.lastUpdateId
+	}
+
+	if upd.Message != nil {
+		msg := *upd.Message
+		f.fillMessage(&msg)
+		upd.Message = &msg
+	}
+
+	if upd.CallbackQuery != nil {
+		cq := *upd.CallbackQuery
+		if cq.Id == "" {
+			cq.Id = strconv.FormatInt(upd.UpdateId, 10)
+		}
+		if cq.From.Id == 0 {
+			cq.From = f.User
+		}
+		if cq.Message == nil {
+			msg := gotgbot.Message{From: &f.Bot.User}
+			f.fillMessage(&msg)
+			cq.Message = msg
+		}
+		if cq.ChatInstance == "" {
+			cq.ChatInstance = strconv.FormatInt(cq.Message.GetChat().Id, 10)
+		}
+		upd.CallbackQuery = &cq
+	}
+}
+
+// fillMessage populates the missing fields of a message.
+func (f *Flow) fillMessage(msg *gotgbot.Message) {
+	if msg.MessageId == 0 {
+		f.lastMessageId++
+		msg.MessageId = f.lastMessageId
+	}
+	if msg.Date == 0 {
+		msg.Date = time.Now().Unix()
+	}
+	if msg.From == nil {
+		user := f.User
+		msg.From = &user
+	}
+	if msg.Chat.Id == 0 {
+		msg.Chat = f.Chat
+	}
+}
+
+// State returns the current state of the conversation for the flow's user and chat; nil if there is no ongoing
+// conversation.
+func (f *Flow) State() *conversation.State {
+	f.t.Helper()
+
+	ctx := ext.NewContext(f.Bot, &gotgbot.Update{Message: &gotgbot.Message{
+		From: &f.User,
+		Chat: f.Chat,
+	}}, nil)
+
+	state, err := f.Conversation.StateStorage.Get(ctx)
+	if err != nil {
+		if errors.Is(err, conversation.ErrKeyNotFound) {
+			return nil
+		}
+		f.t.Fatalf("failed to get conversation state: %v", err)
+	}
+	return state
+}
+
+// ExpectState fails the test if the current conversation state is not the expected key. An empty key expects there to
+// be no ongoing conversation.
+func (f *Flow) ExpectState(key string) {
+	f.t.Helper()
+
+	if err := f.checkState(key); err != nil {
+		f.t.Fatal(err)
+	}
+}
+
+// checkState returns an error describing the difference between the current and expected conversation state, if any.
+func (f *Flow) checkState(key string) error {
+	state := f.State()
+	switch {
+	case key == "" && state != nil:
+		return fmt.Errorf("%w: expected no ongoing conversation, got state %q", errUnexpectedState, state.Key)
+	case key != "" && state == nil:
+		return fmt.Errorf("%w: expected conversation state %q, got no ongoing conversation", errUnexpectedState, key)
+	case key != "" && state.Key != key:
+		return fmt.Errorf("%w: expected conversation state %q, got %q", errUnexpectedState, key, state.Key)
+	}
+	return nil
+}
+
+// Step is a single step of a dialog, as run by Flow.Run.
+type Step struct {
+	// Name describes the step in failure messages. Defaults to the step number.
+	Name string
+	// Update is the update sent by the user; see Text, Command and Callback.
+	Update gotgbot.Update
+	// State is the expected conversation state after the update. An empty state expects there to be no ongoing
+	// conversation.
+	State string
+	// Replies is the expected text of the messages sent by the bot, in order. If nil, replies aren't checked.
+	Replies []string
+	// Check allows for any extra checks on the result of the step.
+	Check func(t testing.TB, r *Result)
+}
+
+// Run sends the update of each step in order, checking the expected conversation state and replies after each one.
+// Any errors returned to the dispatcher fail the test.
+func (f *Flow) Run(steps ...Step) {
+	f.t.Helper()
+
+	for idx, step := range steps {
+		name := step.Name
+		if name == "" {
+			name = fmt.Sprintf("step %d", idx+1)
+		}
+
+		r := f.Send(step.Update)
+		if len(r.Errors) > 0 {
+			f.t.Fatalf("%s: unexpected errors: %v", name, r.Errors)
+		}
+
+		if err := f.checkState(step.State); err != nil {
+			f.t.Fatalf("%s: %v", name, err)
+		}
+
+		if step.Replies != nil {
+			if replies := r.Replies(); !reflect.DeepEqual(replies, step.Replies) {
+				f.t.Fatalf("%s: expected replies %q, got %q", name, step.Replies, replies)
+			}
+		}
+
+		if step.Check != nil {
+			step.Check(f.t, r)
+		}
+	}
+}
+
+// Replies returns the text of all the messages sent with sendMessage, in order.
+func (r *Result) Replies() []string {
+	replies := []string{}
+	for _, c := range r.Calls {
+		if c.Method == "sendMessage" {
+			replies = append(replies, c.Param("text"))
+		}
+	}
+	return replies
+}
+
+// CallsTo returns all the calls made to an API method, in order.
+func (r *Result) CallsTo(method string) []gotgbottest.Call {
+	var calls []gotgbottest.Call
+	for _, c := range r.Calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// ExpectNoErrors fails the test if any errors were returned to the dispatcher.
+func (r *Result) ExpectNoErrors() {
+	r.t.Helper()
+
+	if len(r.Errors) > 0 {
+		r.t.Fatalf("unexpected errors: %v", r.Errors)
+	}
+}
+
+// ExpectNextState fails the test if the handler did not move the conversation to the given state.
+func (r *Result) ExpectNextState(key string) {
+	r.t.Helper()
+
+	if r.Change == nil || r.Change.NextState == nil {
+		r.t.Fatalf("expected a change to state %q, got %v", key, r.Change)
+	}
+	if *r.Change.NextState != key {
+		r.t.Fatalf("expected a change to state %q, got %q", key, *r.Change.NextState)
+	}
+}
+
+// ExpectEnd fails the test if the handler did not end the conversation.
+func (r *Result) ExpectEnd() {
+	r.t.Helper()
+
+	if r.Change == nil || !r.Change.End {
+		r.t.Fatalf("expected the conversation to end, got %v", r.Change)
+	}
+}
+
+// ExpectNoChange fails the test if the handler changed the conversation state.
+func (r *Result) ExpectNoChange() {
+	r.t.Helper()
+
+	if r.Change != nil {
+		r.t.Fatalf("expected no state change, got %v", r.Change)
+	}
+}
+
+// ExpectReply fails the test if the bot did not send a message with the given text.
+func (r *Result) ExpectReply(text string) {
+	r.t.Helper()
+
+	replies := r.Replies()
+	for _, reply := range replies {
+		if reply == text {
+			return
+		}
+	}
+	r.t.Fatalf("expected reply %q, got %q", text, replies)
+}
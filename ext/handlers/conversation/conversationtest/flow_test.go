@@ -0,0 +1,156 @@
+package conversationtest_test
+
+import (
+	"testing"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/conversation/conversationtest"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/filters/callbackquery"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/filters/message"
+)
+
+// newOrderConversation creates a conversation asking the user for a pizza size, and then for confirmation.
+func newOrderConversation() handlers.Conversation {
+	return handlers.NewConversation(
+		[]ext.Handler{handlers.NewCommand("order", func(b *gotgbot.Bot, ctx *ext.Context) error {
+			if _, err := ctx.EffectiveMessage.Reply(b, "Which size?", nil); err != nil {
+				return err
+			}
+			return handlers.NextConversationState("size")
+		})},
+		map[string][]ext.Handler{
+			"size": {handlers.NewMessage(message.Text, func(b *gotgbot.Bot, ctx *ext.Context) error {
+				if ctx.EffectiveMessage.Text != "small" && ctx.EffectiveMessage.Text != "large" {
+					_, err := ctx.EffectiveMessage.Reply(b, "Please pick small or large.", nil)
+					return err
+				}
+				if _, err := ctx.EffectiveMessage.Reply(b, "Confirm your "+ctx.EffectiveMessage.Text+" pizza?", nil); err != nil {
+					return err
+				}
+				return handlers.NextConversationState("confirm")
+			})},
+			"confirm": {handlers.NewCallback(callbackquery.Equal("yes"), func(b *gotgbot.Bot, ctx *ext.Context) error {
+				if _, err := ctx.CallbackQuery.Answer(b, nil); err != nil {
+					return err
+				}
+				if _, err := b.SendMessage(ctx.EffectiveChat.Id, "Ordered!", nil); err != nil {
+					return err
+				}
+				return handlers.EndConversation()
+			})},
+		},
+		&handlers.ConversationOpts{
+			Exits: []ext.Handler{handlers.NewCommand("cancel", func(b *gotgbot.Bot, ctx *ext.Context) error {
+				_, err := ctx.EffectiveMessage.Reply(b, "Cancelled.", nil)
+				if err != nil {
+					return err
+				}
+				return handlers.EndConversation()
+			})},
+		},
+	)
+}
+
+func TestFlowRun(t *testing.T) {
+	for name, steps := range map[string][]conversationtest.Step{
+		"complete order": {
+			{Update: conversationtest.Command("order", ""), State: "size", Replies: []string{"Which size?"}},
+			{Update: conversationtest.Text("medium"), State: "size", Replies: []string{"Please pick small or large."}},
+			{Update: conversationtest.Text("large"), State: "confirm", Replies: []string{"Confirm your large pizza?"}},
+			{
+				Update:  conversationtest.Callback("yes"),
+				State:   "",
+				Replies: []string{"Ordered!"},
+				Check: func(t testing.TB, r *conversationtest.Result) {
+					if calls := r.CallsTo("answerCallbackQuery"); len(calls) != 1 {
+						t.Fatalf("expected the callback query to be answered once, got %d calls", len(calls))
+					}
+				},
+			},
+		},
+		"cancel order": {
+			{Update: conversationtest.Command("order", ""), State: "size"},
+			{Update: conversationtest.Command("cancel", ""), State: "", Replies: []string{"Cancelled."}},
+		},
+		"ignore outside conversation": {
+			{Update: conversationtest.Text("large"), State: "", Replies: []string{}},
+		},
+	} {
+		steps := steps
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conversationtest.NewFlow(t, newOrderConversation(), nil).Run(steps...)
+		})
+	}
+}
+
+func TestFlowResult(t *testing.T) {
+	t.Parallel()
+
+	f := conversationtest.NewFlow(t, newOrderConversation(), &conversationtest.FlowOpts{UserId: 1, ChatId: -100})
+
+	r := f.Command("order", "")
+	r.ExpectNoErrors()
+	r.ExpectNextState("size")
+	r.ExpectReply("Which size?")
+	f.ExpectState("size")
+
+	if calls := r.CallsTo("sendMessage"); len(calls) != 1 || calls[0].Param("chat_id") != "-100" {
+		t.Fatalf("expected a single reply in chat -100, got %+v", calls)
+	}
+
+	r = f.Text("medium")
+	r.ExpectNoChange()
+	f.ExpectState("size")
+
+	f.Text("small").ExpectNextState("confirm")
+
+	// Only the sender's conversation is affected by updates from other users.
+	f.User = gotgbot.User{Id: 2, FirstName: "other"}
+	f.Callback("yes").ExpectNoChange()
+	f.User = gotgbot.User{Id: 1, FirstName: "user"}
+	f.ExpectState("confirm")
+
+	f.Callback("yes").ExpectEnd()
+	f.ExpectState("")
+	if f.State() != nil {
+		t.Fatalf("expected the conversation to be ended")
+	}
+}
+
+func TestFlowErrors(t *testing.T) {
+	t.Parallel()
+
+	f := conversationtest.NewFlow(t, newOrderConversation(), nil)
+	f.Server.FailOnce("sendMessage", 400, "Bad Request: chat not found")
+
+	r := f.Command("order", "")
+	if len(r.Errors) != 1 {
+		t.Fatalf("expected 1 error, got %v", r.Errors)
+	}
+	r.ExpectNoChange()
+	f.ExpectState("")
+}
+
+func TestFlowDefaultExit(t *testing.T) {
+	t.Parallel()
+
+	conv := newOrderConversation()
+	// Exit handlers end the conversation by default, even if they don't return a state change.
+	conv.Exits = []ext.Handler{handlers.NewCommand("cancel", func(b *gotgbot.Bot, ctx *ext.Context) error {
+		_, err := ctx.EffectiveMessage.Reply(b, "Cancelled.", nil)
+		return err
+	})}
+
+	f := conversationtest.NewFlow(t, conv, nil)
+	f.Command("order", "").ExpectNextState("size")
+
+	r := f.Command("cancel", "")
+	r.ExpectNoErrors()
+	r.ExpectEnd()
+	r.ExpectReply("Cancelled.")
+	f.ExpectState("")
+}
@@ -0,0 +1,238 @@
+// Package conversationtest provides a conformance test suite for conversation.Storage implementations, as well as a
+// Flow harness to test handlers.Conversation dialogs end to end.
+//
+// Any Storage implementation, including third-party ones, can be checked by calling TestStorage from a regular go test:
+//
+//	func TestMyStorage(t *testing.T) {
+//		conversationtest.TestStorage(t, func(t *testing.T, strategy conversation.KeyStrategy) conversation.Storage {
+//			return NewMyStorage(strategy)
+//		})
+//	}
+package conversationtest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/conversation"
+)
+
+var (
+	// errKeyStrategy is returned by the failing key strategy, to check that key strategy errors are not swallowed.
+	errKeyStrategy = errors.New("key strategy error")
+	// errUnexpectedState describes a conversation state mismatch in a Flow.
+	errUnexpectedState = errors.New("unexpected conversation state")
+)
+
+// NewStorageFunc creates a new, empty, Storage instance which uses the given KeyStrategy.
+type NewStorageFunc func(t *testing.T, strategy conversation.KeyStrategy) conversation.Storage
+
+// TestStorage runs the conformance test suite against the Storage implementation returned by newStorage.
+// A new Storage is created for each subtest.
+func TestStorage(t *testing.T, newStorage NewStorageFunc) {
+	t.Helper()
+
+	tests := map[string]func(t *testing.T, newStorage NewStorageFunc){
+		"missing key":            testMissingKey,
+		"set and get":            testSetAndGet,
+		"overwrite":              testOverwrite,
+		"delete":                 testDelete,
+		"delete missing key":     testDeleteMissingKey,
+		"key strategy sender":    testKeyStrategySender,
+		"key strategy chat":      testKeyStrategyChat,
+		"key strategy separates": testKeyStrategySenderAndChat,
+		"key strategy errors":    testKeyStrategyErrors,
+		"concurrent access":      testConcurrentAccess,
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			test(t, newStorage)
+		})
+	}
+}
+
+// NewContext builds an ext.Context for a text message sent by a user in a chat, as received by a bot.
+// This is sufficient for all the default key strategies.
+func NewContext(botId int64, userId int64, chatId int64) *ext.Context {
+	chatType := gotgbot.ChatTypeSupergroup
+	if userId == chatId {
+		chatType = gotgbot.ChatTypePrivate
+	}
+
+	return ext.NewContext(&gotgbot.Bot{User: gotgbot.User{Id: botId, IsBot: true}}, &gotgbot.Update{
+		Message: &gotgbot.Message{
+			Text: "test",
+			From: &gotgbot.User{Id: userId, FirstName: "test"},
+			Chat: gotgbot.Chat{Id: chatId, Type: chatType},
+		},
+	}, nil)
+}
+
+// TestState returns a State with all fields populated, to ensure that implementations store the entire struct.
+func TestState(key string) conversation.State {
+	return conversation.State{
+		Key: key,
+		// Use UTC and second precision, since implementations may not preserve locations or monotonic clocks.
+		LastActivity: time.Unix(1700000000, 0).UTC(),
+	}
+}
+
+func mustSet(t *testing.T, s conversation.Storage, ctx *ext.Context, state conversation.State) {
+	t.Helper()
+
+	if err := s.Set(ctx, state); err != nil {
+		t.Fatalf("failed to set state: %v", err)
+	}
+}
+
+func expectState(t *testing.T, s conversation.Storage, ctx *ext.Context, expected *conversation.State) {
+	t.Helper()
+
+	got, err := s.Get(ctx)
+	if expected == nil {
+		if !errors.Is(err, conversation.ErrKeyNotFound) {
+			t.Fatalf("expected ErrKeyNotFound, got state %+v and error %v", got, err)
+		}
+		return
+	}
+
+	if err != nil {
+		t.Fatalf("failed to get state: %v", err)
+	}
+	if !reflect.DeepEqual(*got, *expected) {
+		t.Fatalf("expected state %+v, got %+v", *expected, *got)
+	}
+}
+
+func testMissingKey(t *testing.T, newStorage NewStorageFunc) {
+	s := newStorage(t, conversation.KeyStrategySenderAndChat)
+	expectState(t, s, NewContext(1, 2, 3), nil)
+}
+
+func testSetAndGet(t *testing.T, newStorage NewStorageFunc) {
+	s := newStorage(t, conversation.KeyStrategySenderAndChat)
+	ctx := NewContext(1, 2, 3)
+	state := TestState("step")
+
+	mustSet(t, s, ctx, state)
+	expectState(t, s, ctx, &state)
+}
+
+func testOverwrite(t *testing.T, newStorage NewStorageFunc) {
+	s := newStorage(t, conversation.KeyStrategySenderAndChat)
+	ctx := NewContext(1, 2, 3)
+	first := TestState("first")
+	second := TestState("second")
+
+	mustSet(t, s, ctx, first)
+	mustSet(t, s, ctx, second)
+	expectState(t, s, ctx, &second)
+}
+
+func testDelete(t *testing.T, newStorage NewStorageFunc) {
+	s := newStorage(t, conversation.KeyStrategySenderAndChat)
+	ctx := NewContext(1, 2, 3)
+
+	mustSet(t, s, ctx, TestState("step"))
+	if err := s.Delete(ctx); err != nil {
+		t.Fatalf("failed to delete state: %v", err)
+	}
+	expectState(t, s, ctx, nil)
+}
+
+func testDeleteMissingKey(t *testing.T, newStorage NewStorageFunc) {
+	s := newStorage(t, conversation.KeyStrategySenderAndChat)
+	if err := s.Delete(NewContext(1, 2, 3)); err != nil {
+		t.Fatalf("expected deleting a missing key to succeed, got: %v", err)
+	}
+}
+
+func testKeyStrategySender(t *testing.T, newStorage NewStorageFunc) {
+	s := newStorage(t, conversation.KeyStrategySender)
+	state := TestState("step")
+
+	mustSet(t, s, NewContext(1, 2, 3), state)
+	// Same sender in another chat shares the conversation.
+	expectState(t, s, NewContext(1, 2, 4), &state)
+	// Another sender in the same chat does not.
+	expectState(t, s, NewContext(1, 5, 3), nil)
+	// And neither does another bot.
+	expectState(t, s, NewContext(6, 2, 3), nil)
+}
+
+func testKeyStrategyChat(t *testing.T, newStorage NewStorageFunc) {
+	s := newStorage(t, conversation.KeyStrategyChat)
+	state := TestState("step")
+
+	mustSet(t, s, NewContext(1, 2, 3), state)
+	// Another sender in the same chat shares the conversation.
+	expectState(t, s, NewContext(1, 5, 3), &state)
+	// The same sender in another chat does not.
+	expectState(t, s, NewContext(1, 2, 4), nil)
+}
+
+func testKeyStrategySenderAndChat(t *testing.T, newStorage NewStorageFunc) {
+	s := newStorage(t, conversation.KeyStrategySenderAndChat)
+	state := TestState("step")
+
+	mustSet(t, s, NewContext(1, 2, 3), state)
+	expectState(t, s, NewContext(1, 2, 3), &state)
+	expectState(t, s, NewContext(1, 2, 4), nil)
+	expectState(t, s, NewContext(1, 5, 3), nil)
+}
+
+func testKeyStrategyErrors(t *testing.T, newStorage NewStorageFunc) {
+	s := newStorage(t, func(ctx *ext.Context) (string, error) {
+		return "", errKeyStrategy
+	})
+	ctx := NewContext(1, 2, 3)
+
+	if _, err := s.Get(ctx); !errors.Is(err, errKeyStrategy) {
+		t.Errorf("expected Get to return the key strategy error, got: %v", err)
+	}
+	if err := s.Set(ctx, TestState("step")); !errors.Is(err, errKeyStrategy) {
+		t.Errorf("expected Set to return the key strategy error, got: %v", err)
+	}
+	if err := s.Delete(ctx); !errors.Is(err, errKeyStrategy) {
+		t.Errorf("expected Delete to return the key strategy error, got: %v", err)
+	}
+}
+
+func testConcurrentAccess(t *testing.T, newStorage NewStorageFunc) {
+	s := newStorage(t, conversation.KeyStrategySenderAndChat)
+
+	wg := sync.WaitGroup{}
+	for i := int64(0); i < 10; i++ {
+		wg.Add(1)
+		go func(userId int64) {
+			defer wg.Done()
+
+			ctx := NewContext(1, userId, 100)
+			for j := 0; j < 10; j++ {
+				state := TestState(fmt.Sprintf("step-%d", j))
+				if err := s.Set(ctx, state); err != nil {
+					t.Errorf("failed to set state: %v", err)
+					return
+				}
+				got, err := s.Get(ctx)
+				if err != nil {
+					t.Errorf("failed to get state: %v", err)
+					return
+				}
+				if got.Key != state.Key {
+					t.Errorf("expected state %s, got %s", state.Key, got.Key)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,135 @@
+package conversation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sync"
+
+	"github.com/PaulSonOfLars/gotgbot/v2/ext"
+	"github.com/PaulSonOfLars/gotgbot/v2/internal/atomicfile"
+)
+
+// FileStorage is a thread-safe implementation of the Storage interface, which persists all conversations to a single
+// JSON file on disk. This allows for conversations to survive restarts, without needing an external database.
+//
+// All conversations are kept in memory, and the file is rewritten atomically on every change. As such, it is best
+// suited to bots with a moderate number of concurrent conversations; for larger bots, see SQLStorage.
+type FileStorage struct {
+	// keyStrategy defines how to calculate keys for each conversation.
+	keyStrategy KeyStrategy
+	// path is the location of the file used to persist the conversations.
+	path string
+	// conversations is a map of key -> state, which tracks at which point of each conversation a user/chat is.
+	conversations map[string]State
+	// lock allows us to ensure synchronous data and file access.
+	lock sync.RWMutex
+}
+
+// NewFileStorage creates a new FileStorage, loading any existing conversations from the file at the given path.
+// The file is created on the first write if it does not exist yet.
+func NewFileStorage(path string, strategy KeyStrategy) (*FileStorage, error) {
+	conversations := map[string]State{}
+
+	bs, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read conversation file: %w", err)
+	}
+
+	if len(bs) > 0 {
+		if err := json.Unmarshal(bs, &conversations); err != nil {
+			return nil, fmt.Errorf("failed to decode conversation file: %w", err)
+		}
+	}
+
+	return &FileStorage{
+		keyStrategy:   strategy,
+		path:          path,
+		conversations: conversations,
+		lock:          sync.RWMutex{},
+	}, nil
+}
+
+// Key returns the key used to store the conversation state of the current update.
+func (c *FileStorage) Key(ctx *ext.Context) (string, error) {
+	return StateKey(ctx, c.keyStrategy)
+}
+
+func (c *FileStorage) Get(ctx *ext.Context) (*State, error) {
+	key, err := StateKey(ctx, c.keyStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	s, ok := c.conversations[key]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return &s, nil
+}
+
+func (c *FileStorage) Set(ctx *ext.Context, state State) error {
+	key, err := StateKey(ctx, c.keyStrategy)
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	prev, existed := c.conversations[key]
+	c.conversations[key] = state
+
+	if err := c.save(); err != nil {
+		// Revert the in-memory change, to remain consistent with what is on disk.
+		if existed {
+			c.conversations[key] = prev
+		} else {
+			delete(c.conversations, key)
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *FileStorage) Delete(ctx *ext.Context) error {
+	key, err := StateKey(ctx, c.keyStrategy)
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	prev, ok := c.conversations[key]
+	if !ok {
+		return nil
+	}
+	delete(c.conversations, key)
+
+	if err := c.save(); err != nil {
+		// Revert the in-memory change, to remain consistent with what is on disk.
+		c.conversations[key] = prev
+		return err
+	}
+	return nil
+}
+
+// save writes all conversations to disk, replacing the previous file in a single step.
+// Must be called with the write lock held.
+func (c *FileStorage) save() error {
+	bs, err := json.Marshal(c.conversations)
+	if err != nil {
+		return fmt.Errorf("failed to encode conversations: %w", err)
+	}
+
+	if err := atomicfile.WriteFile(c.path, bs); err != nil {
+		return fmt.Errorf("failed to save conversation file: %w", err)
+	}
+	return nil
+}
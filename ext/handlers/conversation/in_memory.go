@@ -9,6 +9,13 @@ import (
 
 var ErrKeyNotFound = errors.New("conversation key not found")
 
+// Ensure the default storages expose their keys.
+var (
+	_ KeyedStorage = &InMemoryStorage{}
+	_ KeyedStorage = &FileStorage{}
+	_ KeyedStorage = &SQLStorage{}
+)
+
 // InMemoryStorage is a thread-safe in-memory implementation of the Storage interface.
 type InMemoryStorage struct {
 	// keyStrategy defines how to calculate keys for each conversation.
@@ -27,6 +34,11 @@ func NewInMemoryStorage(strategy KeyStrategy) *InMemoryStorage {
 	}
 }
 
+// Key returns the key used to store the conversation state of the current update.
+func (c *InMemoryStorage) Key(ctx *ext.Context) (string, error) {
+	return StateKey(ctx, c.keyStrategy)
+}
+
 func (c *InMemoryStorage) Get(ctx *ext.Context) (*State, error) {
 	key, err := StateKey(ctx, c.keyStrategy)
 	if err != nil {
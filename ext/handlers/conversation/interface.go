@@ -22,3 +22,14 @@ type Storage interface {
 	// Delete ends the conversation, removing the key from the storage.
 	Delete(ctx *ext.Context) error
 }
+
+// KeyedStorage is an optional interface which can be implemented by Storage implementations, to expose the key used to
+// identify each conversation (generally, as determined by a KeyStrategy).
+// This allows conversation handlers to keep track of conversations with the same granularity as the storage; for
+// example, to process updates from the same conversation one at a time.
+type KeyedStorage interface {
+	Storage
+
+	// Key returns the key used to store the conversation state of the current update.
+	Key(ctx *ext.Context) (string, error)
+}
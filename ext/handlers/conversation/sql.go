@@ -0,0 +1,197 @@
+package conversation
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/PaulSonOfLars/gotgbot/v2/ext"
+)
+
+var ErrInvalidTableName = errors.New("invalid table name")
+
+// DefaultSQLTableName is the default table used to store conversations by the SQLStorage.
+const DefaultSQLTableName = "gotgbot_conversations"
+
+// SQLDialect defines which SQL syntax to use to interact with the database.
+type SQLDialect string
+
+const (
+	// SQLDialectSQLite uses "?" placeholders. This is the default dialect.
+	SQLDialectSQLite SQLDialect = "sqlite"
+	// SQLDialectPostgres uses "$1" placeholders.
+	SQLDialectPostgres SQLDialect = "postgres"
+)
+
+// validTableName ensures that the table names we interpolate into queries are safe to use.
+var validTableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// SQLStorage is an implementation of the Storage interface which persists conversations to an SQL database, using the
+// database/sql package. The driver (eg, SQLite or Postgres) is left to the caller; this avoids adding any dependencies
+// to the library.
+//
+// Each conversation is stored as a single row, with the entire State struct encoded as JSON.
+type SQLStorage struct {
+	// keyStrategy defines how to calculate keys for each conversation.
+	keyStrategy KeyStrategy
+	// db is the database connection pool to use.
+	db *sql.DB
+	// timeout is the maximum duration of each query.
+	timeout time.Duration
+
+	// Prepared query strings, built from the table name and dialect.
+	getQuery    string
+	setQuery    string
+	deleteQuery string
+}
+
+// SQLStorageOpts defines the optional parameters for the NewSQLStorage function.
+type SQLStorageOpts struct {
+	// TableName is the name of the table to store conversations in. Defaults to DefaultSQLTableName.
+	TableName string
+	// Dialect is the SQL dialect to use when building queries. Defaults to SQLDialectSQLite.
+	Dialect SQLDialect
+	// SkipCreateTable skips the "CREATE TABLE IF NOT EXISTS" statement run when creating the storage.
+	// Useful if the table is managed through migrations.
+	SkipCreateTable bool
+	// Timeout is the maximum duration of each query. Defaults to 5s. A negative value disables the timeout.
+	Timeout time.Duration
+}
+
+// NewSQLStorage creates a new SQLStorage, and creates the conversation table if it does not already exist.
+func NewSQLStorage(db *sql.DB, strategy KeyStrategy, opts *SQLStorageOpts) (*SQLStorage, error) {
+	return NewSQLStorageWithContext(context.Background(), db, strategy, opts)
+}
+
+// NewSQLStorageWithContext is the same as NewSQLStorage, but uses the given context to create the conversation table.
+func NewSQLStorageWithContext(ctx context.Context, db *sql.DB, strategy KeyStrategy, opts *SQLStorageOpts) (*SQLStorage, error) {
+	tableName := DefaultSQLTableName
+	dialect := SQLDialectSQLite
+	timeout := time.Second * 5
+	createTable := true
+
+	if opts != nil {
+		if opts.TableName != "" {
+			tableName = opts.TableName
+		}
+		if opts.Dialect != "" {
+			dialect = opts.Dialect
+		}
+		if opts.Timeout != 0 {
+			timeout = opts.Timeout
+		}
+		createTable = !opts.SkipCreateTable
+	}
+
+	if !validTableName.MatchString(tableName) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTableName, tableName)
+	}
+
+	p1, p2 := "?", "?"
+	if dialect == SQLDialectPostgres {
+		p1, p2 = "$1", "$2"
+	}
+
+	// nolint:gosec // The table name is validated above, so these queries are safe from injection.
+	s := &SQLStorage{
+		keyStrategy: strategy,
+		db:          db,
+		timeout:     timeout,
+		getQuery:    fmt.Sprintf("SELECT state FROM %s WHERE conversation_key = %s", tableName, p1),
+		setQuery: fmt.Sprintf("INSERT INTO %s (conversation_key, state) VALUES (%s, %s) "+
+			"ON CONFLICT (conversation_key) DO UPDATE SET state = excluded.state", tableName, p1, p2),
+		deleteQuery: fmt.Sprintf("DELETE FROM %s WHERE conversation_key = %s", tableName, p1),
+	}
+
+	if createTable {
+		queryCtx, cancel := s.context(ctx)
+		defer cancel()
+
+		// nolint:gosec // The table name is validated above, so this query is safe from injection.
+		_, err := db.ExecContext(queryCtx, fmt.Sprintf(
+			"CREATE TABLE IF NOT EXISTS %s (conversation_key TEXT PRIMARY KEY, state TEXT NOT NULL)", tableName))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create conversation table: %w", err)
+		}
+	}
+
+	return s, nil
+}
+
+// Key returns the key used to store the conversation state of the current update.
+func (c *SQLStorage) Key(ctx *ext.Context) (string, error) {
+	return StateKey(ctx, c.keyStrategy)
+}
+
+func (c *SQLStorage) Get(ctx *ext.Context) (*State, error) {
+	key, err := StateKey(ctx, c.keyStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	queryCtx, cancel := c.context(ctx)
+	defer cancel()
+
+	var data string
+	err = c.db.QueryRowContext(queryCtx, c.getQuery, key).Scan(&data)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get conversation state: %w", err)
+	}
+
+	var s State
+	if err := json.Unmarshal([]byte(data), &s); err != nil {
+		return nil, fmt.Errorf("failed to decode conversation state: %w", err)
+	}
+	return &s, nil
+}
+
+func (c *SQLStorage) Set(ctx *ext.Context, state State) error {
+	key, err := StateKey(ctx, c.keyStrategy)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to encode conversation state: %w", err)
+	}
+
+	queryCtx, cancel := c.context(ctx)
+	defer cancel()
+
+	if _, err := c.db.ExecContext(queryCtx, c.setQuery, key, string(data)); err != nil {
+		return fmt.Errorf("failed to set conversation state: %w", err)
+	}
+	return nil
+}
+
+func (c *SQLStorage) Delete(ctx *ext.Context) error {
+	key, err := StateKey(ctx, c.keyStrategy)
+	if err != nil {
+		return err
+	}
+
+	queryCtx, cancel := c.context(ctx)
+	defer cancel()
+
+	if _, err := c.db.ExecContext(queryCtx, c.deleteQuery, key); err != nil {
+		return fmt.Errorf("failed to delete conversation state: %w", err)
+	}
+	return nil
+}
+
+// context returns the context to use for each query, derived from the caller's context. This means queries are
+// abandoned when the update's context is cancelled (eg, once the Dispatcher's StopWithContext deadline has passed).
+func (c *SQLStorage) context(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.timeout < 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.timeout)
+}
@@ -0,0 +1,219 @@
+package conversation_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/conversation"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/conversation/conversationtest"
+)
+
+func TestSQLStorage(t *testing.T) {
+	conversationtest.TestStorage(t, func(t *testing.T, strategy conversation.KeyStrategy) conversation.Storage {
+		return newFakeSQLStorage(t, sql.OpenDB(&fakeConnector{db: &fakeDB{}}), strategy, nil)
+	})
+}
+
+func TestSQLStoragePostgresDialect(t *testing.T) {
+	conversationtest.TestStorage(t, func(t *testing.T, strategy conversation.KeyStrategy) conversation.Storage {
+		return newFakeSQLStorage(t, sql.OpenDB(&fakeConnector{db: &fakeDB{}}), strategy, &conversation.SQLStorageOpts{
+			TableName: "custom_conversations",
+			Dialect:   conversation.SQLDialectPostgres,
+		})
+	})
+}
+
+func TestSQLStorageInvalidTableName(t *testing.T) {
+	_, err := conversation.NewSQLStorage(sql.OpenDB(&fakeConnector{db: &fakeDB{}}), conversation.KeyStrategySenderAndChat, &conversation.SQLStorageOpts{
+		TableName: "conversations; DROP TABLE users",
+	})
+	if !errors.Is(err, conversation.ErrInvalidTableName) {
+		t.Fatalf("expected an invalid table name error, got: %v", err)
+	}
+}
+
+func TestSQLStorageUsesUpdateContext(t *testing.T) {
+	s := newFakeSQLStorage(t, sql.OpenDB(&fakeConnector{db: &fakeDB{}}), conversation.KeyStrategySenderAndChat, nil)
+
+	ctx := conversationtest.NewContext(1, 2, 3)
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ctx.Context = cancelledCtx
+
+	if _, err := s.Get(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected get to be cancelled with the update context, got: %v", err)
+	}
+	if err := s.Set(ctx, conversationtest.TestState("step")); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected set to be cancelled with the update context, got: %v", err)
+	}
+	if err := s.Delete(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected delete to be cancelled with the update context, got: %v", err)
+	}
+}
+
+func newFakeSQLStorage(t *testing.T, db *sql.DB, strategy conversation.KeyStrategy, opts *conversation.SQLStorageOpts) *conversation.SQLStorage {
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	s, err := conversation.NewSQLStorage(db, strategy, opts)
+	if err != nil {
+		t.Fatalf("failed to create sql storage: %v", err)
+	}
+	return s
+}
+
+// fakeDB is an in-memory database which understands the handful of queries run by the SQLStorage. It allows for
+// testing the storage through database/sql, without depending on a real SQL driver.
+type fakeDB struct {
+	mu     sync.Mutex
+	tables map[string]map[string]string
+}
+
+// exec runs a query against the database. It returns the selected state for SELECT queries.
+func (db *fakeDB) exec(query string, args []driver.Value) (string, bool, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.tables == nil {
+		db.tables = map[string]map[string]string{}
+	}
+
+	fields := strings.Fields(query)
+	switch {
+	case strings.HasPrefix(query, "CREATE TABLE IF NOT EXISTS "):
+		if _, ok := db.tables[fields[5]]; !ok {
+			db.tables[fields[5]] = map[string]string{}
+		}
+		return "", false, nil
+
+	case strings.HasPrefix(query, "SELECT state FROM "):
+		table, err := db.table(fields[3])
+		if err != nil {
+			return "", false, err
+		}
+		state, ok := table[args[0].(string)]
+		return state, ok, nil
+
+	case strings.HasPrefix(query, "INSERT INTO "):
+		table, err := db.table(fields[2])
+		if err != nil {
+			return "", false, err
+		}
+		table[args[0].(string)] = args[1].(string)
+		return "", false, nil
+
+	case strings.HasPrefix(query, "DELETE FROM "):
+		table, err := db.table(fields[2])
+		if err != nil {
+			return "", false, err
+		}
+		delete(table, args[0].(string))
+		return "", false, nil
+	}
+
+	return "", false, fmt.Errorf("unsupported query: %s", query)
+}
+
+func (db *fakeDB) table(name string) (map[string]string, error) {
+	table, ok := db.tables[name]
+	if !ok {
+		return nil, fmt.Errorf("no such table: %s", name)
+	}
+	return table, nil
+}
+
+type fakeConnector struct {
+	db *fakeDB
+}
+
+func (c *fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{db: c.db}, nil
+}
+
+func (c *fakeConnector) Driver() driver.Driver {
+	return fakeDriver{}
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) {
+	return nil, errors.New("the fake driver can only be used through a connector")
+}
+
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{db: c.db, query: query}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type fakeStmt struct {
+	db    *fakeDB
+	query string
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if _, _, err := s.db.exec(s.query, args); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	state, ok, err := s.db.exec(s.query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := &fakeRows{}
+	if ok {
+		rows.states = []string{state}
+	}
+	return rows, nil
+}
+
+type fakeRows struct {
+	states []string
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"state"}
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.states) == 0 {
+		return io.EOF
+	}
+	dest[0] = r.states[0]
+	r.states = r.states[1:]
+	return nil
+}
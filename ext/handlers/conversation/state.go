@@ -1,5 +1,7 @@
 package conversation
 
+import "time"
+
 // State stores all the variables relevant to the current conversation state.
 //
 // Note: More keys may be added in the future to support additional features.
@@ -7,5 +9,8 @@ package conversation
 // individual fields.
 type State struct {
 	// Key represents the name of the current state, as defined in the States map of handlers.Conversation.
-	Key string
+	Key string `json:"key"`
+	// LastActivity is the last time an update was handled as part of this conversation. This is used to determine
+	// whether a conversation has timed out.
+	LastActivity time.Time `json:"last_activity"`
 }
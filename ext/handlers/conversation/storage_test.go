@@ -0,0 +1,52 @@
+package conversation_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/conversation"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/conversation/conversationtest"
+)
+
+func TestInMemoryStorage(t *testing.T) {
+	conversationtest.TestStorage(t, func(t *testing.T, strategy conversation.KeyStrategy) conversation.Storage {
+		return conversation.NewInMemoryStorage(strategy)
+	})
+}
+
+func TestFileStorage(t *testing.T) {
+	conversationtest.TestStorage(t, func(t *testing.T, strategy conversation.KeyStrategy) conversation.Storage {
+		s, err := conversation.NewFileStorage(filepath.Join(t.TempDir(), "conversations.json"), strategy)
+		if err != nil {
+			t.Fatalf("failed to create file storage: %v", err)
+		}
+		return s
+	})
+}
+
+func TestFileStoragePersistsConversations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "conversations.json")
+	ctx := conversationtest.NewContext(1, 2, 3)
+	state := conversationtest.TestState("step")
+
+	s, err := conversation.NewFileStorage(path, conversation.KeyStrategySenderAndChat)
+	if err != nil {
+		t.Fatalf("failed to create file storage: %v", err)
+	}
+	if err := s.Set(ctx, state); err != nil {
+		t.Fatalf("failed to set state: %v", err)
+	}
+
+	// Reopening the same file should restore the conversation.
+	s, err = conversation.NewFileStorage(path, conversation.KeyStrategySenderAndChat)
+	if err != nil {
+		t.Fatalf("failed to reopen file storage: %v", err)
+	}
+	got, err := s.Get(ctx)
+	if err != nil {
+		t.Fatalf("failed to get state after reopening: %v", err)
+	}
+	if *got != state {
+		t.Fatalf("expected state %+v, got %+v", state, *got)
+	}
+}
@@ -1,14 +1,18 @@
 package handlers_test
 
 import (
+	"context"
 	"errors"
 	"math/rand"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/PaulSonOfLars/gotgbot/v2"
 	"github.com/PaulSonOfLars/gotgbot/v2/ext"
 	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers"
 	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/conversation"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/filters"
 	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/filters/message"
 )
 
@@ -361,6 +365,326 @@ func TestEmptyKeyConversation(t *testing.T) {
 
 }
 
+func TestConversationTimeout(t *testing.T) {
+	b := NewTestBot()
+
+	const nextStep = "nextStep"
+	const timeout = time.Millisecond * 500
+	timedOut := make(chan struct{})
+
+	conv := handlers.NewConversation(
+		[]ext.Handler{handlers.NewCommand("start", func(b *gotgbot.Bot, ctx *ext.Context) error {
+			return handlers.NextConversationState(nextStep)
+		})},
+		map[string][]ext.Handler{
+			nextStep: {handlers.NewMessage(message.Contains("message"), func(b *gotgbot.Bot, ctx *ext.Context) error {
+				return nil // stay in the same state
+			})},
+		},
+		&handlers.ConversationOpts{
+			Timeout: timeout,
+			TimeoutHandlers: []ext.Handler{handlers.NewMessage(message.All, func(b *gotgbot.Bot, ctx *ext.Context) error {
+				close(timedOut)
+				return nil
+			})},
+		},
+	)
+
+	var userId int64 = 123
+	var chatId int64 = 1234
+
+	startCommand := NewCommandMessage(b, userId, chatId, "start", []string{})
+	runHandler(t, b, &conv, startCommand, "", nextStep)
+
+	// Keep the conversation active for longer than the timeout; it should not expire. Updates are sent well within
+	// the timeout of each other, to leave plenty of margin for slow test runs.
+	textMessage := NewMessage(b, userId, chatId, "message")
+	for i := 0; i < 8; i++ {
+		time.Sleep(timeout / 5)
+		runHandler(t, b, &conv, textMessage, nextStep, nextStep)
+	}
+
+	// Then go idle; the timeout handler should be called without any new updates.
+	select {
+	case <-timedOut:
+	case <-time.After(timeout * 10):
+		t.Fatalf("expected the timeout handler to have run")
+	}
+
+	// Ensure conversation has ended.
+	checkExpectedState(t, &conv, textMessage, "")
+}
+
+func TestExpiredConversationWithoutTimer(t *testing.T) {
+	b := NewTestBot()
+
+	const nextStep = "nextStep"
+	var timedOut bool
+
+	conv := handlers.NewConversation(
+		[]ext.Handler{handlers.NewCommand("start", func(b *gotgbot.Bot, ctx *ext.Context) error {
+			return handlers.NextConversationState(nextStep)
+		})},
+		map[string][]ext.Handler{
+			nextStep: {handlers.NewMessage(message.Contains("message"), func(b *gotgbot.Bot, ctx *ext.Context) error {
+				t.Fatalf("internal handler should not have run for an expired conversation")
+				return nil
+			})},
+		},
+		&handlers.ConversationOpts{
+			Timeout: time.Minute,
+			TimeoutHandlers: []ext.Handler{handlers.NewMessage(message.All, func(b *gotgbot.Bot, ctx *ext.Context) error {
+				timedOut = true
+				return handlers.NextConversationState(nextStep) // should be ignored
+			})},
+		},
+	)
+
+	var userId int64 = 123
+	var chatId int64 = 1234
+
+	// Emulate a conversation which expired while the bot was offline.
+	textMessage := NewMessage(b, userId, chatId, "message")
+	err := conv.StateStorage.Set(textMessage, conversation.State{Key: nextStep, LastActivity: time.Now().Add(-time.Hour)})
+	if err != nil {
+		t.Fatalf("failed to set conversation state: %v", err)
+	}
+
+	runHandler(t, b, &conv, textMessage, nextStep, "")
+	if !timedOut {
+		t.Fatalf("expected the timeout handler to have run")
+	}
+
+	// The entrypoint can then start a new conversation.
+	startCommand := NewCommandMessage(b, userId, chatId, "start", []string{})
+	runHandler(t, b, &conv, startCommand, "", nextStep)
+}
+
+func TestExpiredConversationWithoutHandlers(t *testing.T) {
+	b := NewTestBot()
+
+	const nextStep = "nextStep"
+
+	conv := handlers.NewConversation(
+		[]ext.Handler{handlers.NewCommand("start", func(b *gotgbot.Bot, ctx *ext.Context) error {
+			return handlers.NextConversationState(nextStep)
+		})},
+		map[string][]ext.Handler{
+			nextStep: {handlers.NewMessage(message.All, func(b *gotgbot.Bot, ctx *ext.Context) error {
+				t.Fatalf("internal handler should not have run for an expired conversation")
+				return nil
+			})},
+		},
+		&handlers.ConversationOpts{
+			Timeout: time.Minute,
+		},
+	)
+
+	var userId int64 = 123
+	var chatId int64 = 1234
+
+	// Emulate a conversation which expired while the bot was offline.
+	textMessage := NewMessage(b, userId, chatId, "message")
+	err := conv.StateStorage.Set(textMessage, conversation.State{Key: nextStep, LastActivity: time.Now().Add(-time.Hour)})
+	if err != nil {
+		t.Fatalf("failed to set conversation state: %v", err)
+	}
+
+	// No handler matches the update, but the expired conversation should still be ended in the background.
+	if conv.CheckUpdate(b, textMessage) {
+		t.Fatalf("expected the expired conversation not to match the update")
+	}
+
+	deadline := time.Now().Add(time.Second * 5)
+	for {
+		_, err := conv.StateStorage.Get(textMessage)
+		if errors.Is(err, conversation.ErrKeyNotFound) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the expired conversation to be ended, got: %v", err)
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+}
+
+func TestBlockingConversation(t *testing.T) {
+	b := NewTestBot()
+
+	const firstStep = "firstStep"
+	const secondStep = "secondStep"
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var secondRan bool
+
+	conv := handlers.NewConversation(
+		[]ext.Handler{handlers.NewCommand("start", func(b *gotgbot.Bot, ctx *ext.Context) error {
+			return handlers.NextConversationState(firstStep)
+		})},
+		map[string][]ext.Handler{
+			firstStep: {handlers.NewMessage(message.Contains("first"), func(b *gotgbot.Bot, ctx *ext.Context) error {
+				// Simulate a slow handler, which only finishes once the second update is waiting.
+				close(started)
+				<-release
+				return handlers.NextConversationState(secondStep)
+			})},
+			secondStep: {handlers.NewMessage(message.Contains("second"), func(b *gotgbot.Bot, ctx *ext.Context) error {
+				secondRan = true
+				return handlers.EndConversation()
+			})},
+		},
+		&handlers.ConversationOpts{
+			Blocking: true,
+			// Waiting handlers are checked right before blocked updates wait for their turn; use this to release the
+			// first update.
+			WaitingHandlers: []ext.Handler{handlers.NewMessage(func(msg *gotgbot.Message) bool {
+				close(release)
+				return false
+			}, nil)},
+		},
+	)
+
+	var userId int64 = 123
+	var chatId int64 = 1234
+
+	startCommand := NewCommandMessage(b, userId, chatId, "start", []string{})
+	runHandler(t, b, &conv, startCommand, "", firstStep)
+
+	// Send both messages concurrently, as the dispatcher would; the second one should wait for the first.
+	first := NewMessage(b, userId, chatId, "first")
+	second := NewMessage(b, userId, chatId, "second")
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := conv.HandleUpdate(b, first); err != nil {
+			t.Errorf("unexpected error from first handler: %v", err)
+		}
+	}()
+
+	<-started
+	if !conv.CheckUpdate(b, second) {
+		t.Fatalf("expected the blocked update to be accepted")
+	}
+	if err := conv.HandleUpdate(b, second); err != nil {
+		t.Fatalf("unexpected error from second handler: %v", err)
+	}
+	wg.Wait()
+
+	if !secondRan {
+		t.Fatalf("expected the second handler to run after the first one")
+	}
+	checkExpectedState(t, &conv, second, "")
+}
+
+func TestBlockingConversationWaitingHandlers(t *testing.T) {
+	b := NewTestBot()
+
+	const nextStep = "nextStep"
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var waited bool
+
+	conv := handlers.NewConversation(
+		[]ext.Handler{handlers.NewCommand("start", func(b *gotgbot.Bot, ctx *ext.Context) error {
+			close(started)
+			<-release
+			return handlers.NextConversationState(nextStep)
+		})},
+		map[string][]ext.Handler{
+			nextStep: {handlers.NewMessage(message.All, func(b *gotgbot.Bot, ctx *ext.Context) error {
+				t.Errorf("state handlers should not run while the conversation is busy")
+				return nil
+			})},
+		},
+		&handlers.ConversationOpts{
+			Blocking: true,
+			WaitingHandlers: []ext.Handler{handlers.NewMessage(message.All, func(b *gotgbot.Bot, ctx *ext.Context) error {
+				waited = true
+				return handlers.EndConversation() // should be ignored
+			})},
+		},
+	)
+
+	var userId int64 = 123
+	var chatId int64 = 1234
+
+	startCommand := NewCommandMessage(b, userId, chatId, "start", []string{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := conv.HandleUpdate(b, startCommand); err != nil {
+			t.Errorf("unexpected error from entrypoint: %v", err)
+		}
+	}()
+
+	<-started
+	textMessage := NewMessage(b, userId, chatId, "message")
+	if err := conv.HandleUpdate(b, textMessage); err != nil {
+		t.Fatalf("unexpected error from waiting handler: %v", err)
+	}
+	if !waited {
+		t.Fatalf("expected the waiting handler to have run")
+	}
+
+	close(release)
+	<-done
+	checkExpectedState(t, &conv, textMessage, nextStep)
+}
+
+func TestBlockingConversationCancelledWhileWaiting(t *testing.T) {
+	b := NewTestBot()
+
+	const nextStep = "nextStep"
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	conv := handlers.NewConversation(
+		[]ext.Handler{handlers.NewCommand("start", func(b *gotgbot.Bot, ctx *ext.Context) error {
+			close(started)
+			<-release
+			return handlers.NextConversationState(nextStep)
+		})},
+		map[string][]ext.Handler{
+			nextStep: {handlers.NewMessage(message.All, func(b *gotgbot.Bot, ctx *ext.Context) error {
+				t.Errorf("cancelled updates should not be handled")
+				return nil
+			})},
+		},
+		&handlers.ConversationOpts{
+			Blocking: true,
+		},
+	)
+
+	var userId int64 = 123
+	var chatId int64 = 1234
+
+	startCommand := NewCommandMessage(b, userId, chatId, "start", []string{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := conv.HandleUpdate(b, startCommand); err != nil {
+			t.Errorf("unexpected error from entrypoint: %v", err)
+		}
+	}()
+	defer func() {
+		close(release)
+		<-done
+	}()
+
+	<-started
+	// The update is cancelled while it waits for its turn, as happens when the dispatcher is stopped.
+	ctx, cancel := context.WithCancel(context.Background())
+	textMessage := NewMessage(b, userId, chatId, "message")
+	textMessage.Context = ctx
+	cancel()
+
+	if err := conv.HandleUpdate(b, textMessage); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the waiting update to be cancelled, got: %v", err)
+	}
+}
+
 // runHandler ensures that the incoming update will trigger the conversation.
 func runHandler(t *testing.T, b *gotgbot.Bot, conv *handlers.Conversation, message *ext.Context, currentState string, nextState string) {
 	t.Helper()
@@ -400,3 +724,22 @@ func checkExpectedState(t *testing.T, conv *handlers.Conversation, message *ext.
 		t.Fatalf("expected the conversation to be at '%s', was '%s'", nextState, currentState)
 	}
 }
+
+func TestConversationMessageFilter(t *testing.T) {
+	b := NewTestBot()
+
+	filter := filters.And(
+		handlers.ConversationMessageFilter(message.Text),
+		handlers.ConversationMessageFilter(filters.Not(message.Private)),
+	)
+
+	if filter(NewMessage(b, 1, 1, "private")) {
+		t.Errorf("expected private messages to not match")
+	}
+	if !filter(NewMessage(b, 1, 2, "group")) {
+		t.Errorf("expected group messages to match")
+	}
+	if filter(ext.NewContext(b, &gotgbot.Update{PollAnswer: &gotgbot.PollAnswer{}}, nil)) {
+		t.Errorf("expected updates without messages to not match")
+	}
+}
@@ -0,0 +1,53 @@
+package filters
+
+// The following combinators work with all the filter types defined in this package, as well as any other boolean
+// func, such as handlers.ConversationFilter. For example:
+//
+//	handlers.NewMessage(filters.And(message.Text, filters.Not(message.Command)), handleText)
+
+// And returns a filter which matches if both filters match. f2 is not checked if f1 doesn't match.
+func And[F ~func(T) bool, T any](f1 F, f2 F) F {
+	return func(t T) bool {
+		return f1(t) && f2(t)
+	}
+}
+
+// Or returns a filter which matches if either filter matches. f2 is not checked if f1 matches.
+func Or[F ~func(T) bool, T any](f1 F, f2 F) F {
+	return func(t T) bool {
+		return f1(t) || f2(t)
+	}
+}
+
+// Not returns a filter which matches if the filter does not match.
+func Not[F ~func(T) bool, T any](f F) F {
+	return func(t T) bool {
+		return !f(t)
+	}
+}
+
+// All returns a filter which matches if all the filters match. Filters are checked in order, stopping at the first
+// one which doesn't match. If no filters are given, the returned filter always matches.
+func All[F ~func(T) bool, T any](fs ...F) F {
+	return func(t T) bool {
+		for _, f := range fs {
+			if !f(t) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Any returns a filter which matches if any of the filters match. Filters are checked in order, stopping at the first
+// one which matches. If no filters are given, the returned filter never matches.
+func Any[F ~func(T) bool, T any](fs ...F) F {
+	return func(t T) bool {
+		for _, f := range fs {
+			if f(t) {
+				return true
+			}
+		}
+		return false
+	}
+}
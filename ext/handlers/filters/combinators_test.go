@@ -0,0 +1,81 @@
+package filters_test
+
+import (
+	"testing"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/filters"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/filters/callbackquery"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/filters/message"
+)
+
+func TestMessageCombinators(t *testing.T) {
+	text := &gotgbot.Message{Text: "hello", Chat: gotgbot.Chat{Id: 1}}
+	command := &gotgbot.Message{
+		Text:     "/start",
+		Entities: []gotgbot.MessageEntity{{Type: "bot_command", Offset: 0, Length: 6}},
+		Chat:     gotgbot.Chat{Id: 2},
+	}
+
+	for name, tc := range map[string]struct {
+		filter   filters.Message
+		expected map[*gotgbot.Message]bool
+	}{
+		"and": {
+			filter:   filters.And(message.Text, filters.Not(message.Command)),
+			expected: map[*gotgbot.Message]bool{text: true, command: false},
+		},
+		"or": {
+			filter:   filters.Or(message.ChatID(1), message.Command),
+			expected: map[*gotgbot.Message]bool{text: true, command: true},
+		},
+		"not": {
+			filter:   filters.Not(message.ChatID(1)),
+			expected: map[*gotgbot.Message]bool{text: false, command: true},
+		},
+		"all": {
+			filter:   filters.All(message.Text, message.Command, message.ChatID(2)),
+			expected: map[*gotgbot.Message]bool{text: false, command: true},
+		},
+		"all empty": {
+			filter:   filters.All[filters.Message](),
+			expected: map[*gotgbot.Message]bool{text: true, command: true},
+		},
+		"any": {
+			filter:   filters.Any(message.ChatID(3), message.ChatID(2)),
+			expected: map[*gotgbot.Message]bool{text: false, command: true},
+		},
+		"any empty": {
+			filter:   filters.Any[filters.Message](),
+			expected: map[*gotgbot.Message]bool{text: false, command: false},
+		},
+	} {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			for msg, expected := range tc.expected {
+				if got := tc.filter(msg); got != expected {
+					t.Errorf("expected %v for message %q, got %v", expected, msg.Text, got)
+				}
+			}
+		})
+	}
+}
+
+func TestCombinatorsShortCircuit(t *testing.T) {
+	called := false
+	unreachable := func(cq *gotgbot.CallbackQuery) bool {
+		called = true
+		return true
+	}
+
+	cq := &gotgbot.CallbackQuery{Data: "data"}
+	if filters.And(callbackquery.Equal("other"), unreachable)(cq) {
+		t.Errorf("expected And to not match")
+	}
+	if !filters.Or(callbackquery.Equal("data"), unreachable)(cq) {
+		t.Errorf("expected Or to match")
+	}
+	if called {
+		t.Errorf("expected the second filter to not be called")
+	}
+}
@@ -36,23 +36,23 @@ func QuerySuffix(suffix string) filters.InlineQuery {
 }
 
 func Sender(iq *gotgbot.InlineQuery) bool {
-	return iq.ChatType == "sender"
+	return iq.ChatType == gotgbot.InlineQueryChatTypeSender
 }
 
 func Private(iq *gotgbot.InlineQuery) bool {
-	return iq.ChatType == "private"
+	return iq.ChatType == gotgbot.InlineQueryChatTypePrivate
 }
 
 func Group(iq *gotgbot.InlineQuery) bool {
-	return iq.ChatType == "group"
+	return iq.ChatType == gotgbot.InlineQueryChatTypeGroup
 }
 
 func Supergroup(iq *gotgbot.InlineQuery) bool {
-	return iq.ChatType == "supergroup"
+	return iq.ChatType == gotgbot.InlineQueryChatTypeSupergroup
 }
 
 func Channel(iq *gotgbot.InlineQuery) bool {
-	return iq.ChatType == "channel"
+	return iq.ChatType == gotgbot.InlineQueryChatTypeChannel
 }
 
 func Location(iq *gotgbot.InlineQuery) bool {
@@ -71,26 +71,26 @@ func Reply(msg *gotgbot.Message) bool {
 	return msg.ReplyToMessage != nil
 }
 
-func ChatType(t string) filters.Message {
+func ChatType(t gotgbot.ChatType) filters.Message {
 	return func(m *gotgbot.Message) bool {
 		return m.Chat.Type == t
 	}
 }
 
 func Private(msg *gotgbot.Message) bool {
-	return msg.Chat.Type == "private"
+	return msg.Chat.Type == gotgbot.ChatTypePrivate
 }
 
 func Group(msg *gotgbot.Message) bool {
-	return msg.Chat.Type == "group"
+	return msg.Chat.Type == gotgbot.ChatTypeGroup
 }
 
 func Supergroup(msg *gotgbot.Message) bool {
-	return msg.Chat.Type == "supergroup"
+	return msg.Chat.Type == gotgbot.ChatTypeSupergroup
 }
 
 func Channel(msg *gotgbot.Message) bool {
-	return msg.Chat.Type == "channel"
+	return msg.Chat.Type == gotgbot.ChatTypeChannel
 }
 
 func Business(msg *gotgbot.Message) bool {
@@ -136,7 +136,7 @@ func Caption(msg *gotgbot.Message) bool {
 
 func Command(msg *gotgbot.Message) bool {
 	ents := msg.GetEntities()
-	return len(ents) > 0 && ents[0].Type == "bot_command" && ents[0].Offset == 0
+	return len(ents) > 0 && ents[0].Type == gotgbot.MessageEntityTypeBotCommand && ents[0].Offset == 0
 }
 
 func Animation(msg *gotgbot.Message) bool {
@@ -219,7 +219,7 @@ func Entities(m *gotgbot.Message) bool {
 	return len(m.Entities) > 0
 }
 
-func Entity(entType string) filters.Message {
+func Entity(entType gotgbot.MessageEntityType) filters.Message {
 	return func(m *gotgbot.Message) bool {
 		for _, ent := range m.Entities {
 			if ent.Type == entType {
@@ -234,7 +234,7 @@ func CaptionEntities(m *gotgbot.Message) bool {
 	return len(m.CaptionEntities) > 0
 }
 
-func CaptionEntity(entType string) filters.Message {
+func CaptionEntity(entType gotgbot.MessageEntityType) filters.Message {
 	return func(m *gotgbot.Message) bool {
 		for _, ent := range m.CaptionEntities {
 			if ent.Type == entType {
@@ -15,16 +15,16 @@ func Id(id string) filters.Poll {
 	}
 }
 
-func Type(t string) filters.Poll {
+func Type(t gotgbot.PollType) filters.Poll {
 	return func(p *gotgbot.Poll) bool {
 		return p.Type == t
 	}
 }
 
 func Regular(p *gotgbot.Poll) bool {
-	return p.Type == "regular"
+	return p.Type == gotgbot.PollTypeRegular
 }
 
 func Quiz(p *gotgbot.Poll) bool {
-	return p.Type == "quiz"
+	return p.Type == gotgbot.PollTypeQuiz
 }
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext"
+)
+
+// WithMiddleware wraps a handler with middleware which only applies to that handler.
+// These are called after any dispatcher or group middleware.
+type WithMiddleware struct {
+	// Middleware to apply to the handler, outermost first.
+	Middleware []ext.Middleware
+	// Inlined version of parent handler to inherit methods.
+	ext.Handler
+}
+
+func (w WithMiddleware) HandleUpdate(b *gotgbot.Bot, ctx *ext.Context) error {
+	return ext.ChainMiddleware(w.Handler.HandleUpdate, w.Middleware...)(b, ctx)
+}
+
+func NewMiddlewareHandler(handler ext.Handler, middleware ...ext.Middleware) WithMiddleware {
+	return WithMiddleware{
+		Middleware: middleware,
+		Handler:    handler,
+	}
+}
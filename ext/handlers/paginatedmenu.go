@@ -0,0 +1,227 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/callbackdata"
+)
+
+// ErrNoMenuChat is returned when sending a PaginatedMenu for an update which doesn't have a chat.
+var ErrNoMenuChat = errors.New("update has no chat to send the menu to")
+
+// defaultMenuPageSize is the default maximum number of items per page of a PaginatedMenu.
+const defaultMenuPageSize = 10
+
+// PaginatedMenuItems returns all the buttons to display in a PaginatedMenu, for the current update.
+type PaginatedMenuItems func(b *gotgbot.Bot, ctx *ext.Context) ([]gotgbot.InlineKeyboardButton, error)
+
+// PaginatedMenu displays a list of buttons as an inline keyboard, split into pages with previous/next buttons.
+// The menu is also a handler; once added to the dispatcher, it handles the callback queries of its navigation buttons,
+// and edits the keyboard in place to show the requested page.
+//
+// The item buttons are not handled by the menu; they should have their own handlers.
+type PaginatedMenu struct {
+	// Items returns the buttons to display in the menu. This is called again every time the page changes.
+	Items PaginatedMenuItems
+	// PageSize is the maximum number of items per page. If PageSize <= 0, the default of 10 is used instead.
+	PageSize int
+	// Columns is the number of items per row.
+	Columns int
+	// PreviousText is the text of the button to go to the previous page.
+	PreviousText string
+	// NextText is the text of the button to go to the next page.
+	NextText string
+
+	// id is the identifier of the menu, as used in the callback data.
+	id string
+	// codec encodes the navigation callback data.
+	codec *callbackdata.Codec[menuCallback]
+}
+
+// PaginatedMenuOpts defines the optional parameters for the NewPaginatedMenu function.
+type PaginatedMenuOpts struct {
+	// PageSize is the maximum number of items per page. Defaults to 10.
+	PageSize int
+	// Columns is the number of items per row. Defaults to 1.
+	Columns int
+	// PreviousText is the text of the button to go to the previous page. Defaults to "« Previous".
+	PreviousText string
+	// NextText is the text of the button to go to the next page. Defaults to "Next »".
+	NextText string
+}
+
+// menuCallback is the callback data of the navigation buttons of a PaginatedMenu.
+type menuCallback struct {
+	// Page is the page to display.
+	Page int
+	// Current is set for the page indicator, which doesn't change the page.
+	Current bool
+}
+
+// NewPaginatedMenu creates a new PaginatedMenu. The id identifies the menu in the callback data of its navigation
+// buttons; it must be unique across all the bot's menus, and follows the rules of callbackdata prefixes.
+func NewPaginatedMenu(id string, items PaginatedMenuItems, opts *PaginatedMenuOpts) (*PaginatedMenu, error) {
+	codec, err := callbackdata.New[menuCallback](id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create menu callback data codec: %w", err)
+	}
+
+	m := &PaginatedMenu{
+		Items:        items,
+		PageSize:     defaultMenuPageSize,
+		Columns:      1,
+		PreviousText: "« Previous",
+		NextText:     "Next »",
+		id:           id,
+		codec:        codec,
+	}
+
+	if opts != nil {
+		if opts.PageSize > 0 {
+			m.PageSize = opts.PageSize
+		}
+		if opts.Columns > 0 {
+			m.Columns = opts.Columns
+		}
+		if opts.PreviousText != "" {
+			m.PreviousText = opts.PreviousText
+		}
+		if opts.NextText != "" {
+			m.NextText = opts.NextText
+		}
+	}
+
+	return m, nil
+}
+
+// Markup builds the inline keyboard for the requested page. Out of range pages are clamped to the first or last page.
+func (m *PaginatedMenu) Markup(b *gotgbot.Bot, ctx *ext.Context, page int) (gotgbot.InlineKeyboardMarkup, error) {
+	items, err := m.Items(b, ctx)
+	if err != nil {
+		return gotgbot.InlineKeyboardMarkup{}, fmt.Errorf("failed to get menu items: %w", err)
+	}
+
+	pageSize := m.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultMenuPageSize
+	}
+
+	pages := (len(items) + pageSize - 1) / pageSize
+	if page >= pages {
+		page = pages - 1
+	}
+	if page < 0 {
+		page = 0
+	}
+
+	start := page * pageSize
+	end := start + pageSize
+	if end > len(items) {
+		end = len(items)
+	}
+
+	k := gotgbot.NewInlineKeyboardBuilder().Columns(m.Columns, items[start:end]...)
+	if pages <= 1 {
+		return k.Build(), nil
+	}
+
+	var nav []gotgbot.InlineKeyboardButton
+	if page > 0 {
+		prev, err := m.button(m.PreviousText, menuCallback{Page: page - 1})
+		if err != nil {
+			return gotgbot.InlineKeyboardMarkup{}, err
+		}
+		nav = append(nav, prev)
+	}
+
+	current, err := m.button(strconv.Itoa(page+1)+"/"+strconv.Itoa(pages), menuCallback{Page: page, Current: true})
+	if err != nil {
+		return gotgbot.InlineKeyboardMarkup{}, err
+	}
+	nav = append(nav, current)
+
+	if page < pages-1 {
+		next, err := m.button(m.NextText, menuCallback{Page: page + 1})
+		if err != nil {
+			return gotgbot.InlineKeyboardMarkup{}, err
+		}
+		nav = append(nav, next)
+	}
+
+	return k.Row(nav...).Build(), nil
+}
+
+// button creates a navigation button.
+func (m *PaginatedMenu) button(text string, data menuCallback) (gotgbot.InlineKeyboardButton, error) {
+	encoded, err := m.codec.Encode(data)
+	if err != nil {
+		return gotgbot.InlineKeyboardButton{}, fmt.Errorf("failed to encode menu callback data: %w", err)
+	}
+	return gotgbot.InlineKeyboardButton{Text: text, CallbackData: encoded}, nil
+}
+
+// Send sends a message to the current chat, with the first page of the menu as its inline keyboard. Any reply markup
+// set in the opts is overridden; the opts themselves are left unchanged.
+// If the update has no chat, ErrNoMenuChat is returned.
+func (m *PaginatedMenu) Send(b *gotgbot.Bot, ctx *ext.Context, text string, opts *gotgbot.SendMessageOpts) (*gotgbot.Message, error) {
+	if ctx.EffectiveChat == nil {
+		return nil, ErrNoMenuChat
+	}
+
+	markup, err := m.Markup(b, ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	sendOpts := gotgbot.SendMessageOpts{}
+	if opts != nil {
+		sendOpts = *opts
+	}
+	sendOpts.ReplyMarkup = markup
+
+	return b.SendMessage(ctx.EffectiveChat.Id, text, &sendOpts)
+}
+
+func (m *PaginatedMenu) CheckUpdate(b *gotgbot.Bot, ctx *ext.Context) bool {
+	return ctx.CallbackQuery != nil && m.codec.Match(ctx.CallbackQuery.Data)
+}
+
+func (m *PaginatedMenu) HandleUpdate(b *gotgbot.Bot, ctx *ext.Context) error {
+	cq := ctx.CallbackQuery
+
+	data, err := m.codec.Decode(cq.Data)
+	if err != nil {
+		return fmt.Errorf("failed to decode menu callback data: %w", err)
+	}
+
+	if !data.Current {
+		markup, err := m.Markup(b, ctx, data.Page)
+		if err != nil {
+			return err
+		}
+
+		editOpts := &gotgbot.EditMessageReplyMarkupOpts{ReplyMarkup: markup}
+		if ctx.EffectiveMessage != nil {
+			_, _, err = ctx.EffectiveMessage.EditReplyMarkup(b, editOpts)
+		} else {
+			editOpts.InlineMessageId = cq.InlineMessageId
+			_, _, err = b.EditMessageReplyMarkup(editOpts)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to edit menu: %w", err)
+		}
+	}
+
+	if _, err := cq.Answer(b, nil); err != nil {
+		return fmt.Errorf("failed to answer menu callback query: %w", err)
+	}
+	return nil
+}
+
+func (m *PaginatedMenu) Name() string {
+	return "paginated_menu_" + m.id
+}
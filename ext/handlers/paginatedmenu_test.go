@@ -0,0 +1,188 @@
+package handlers_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers"
+	"github.com/PaulSonOfLars/gotgbot/v2/gotgbottest"
+)
+
+func TestPaginatedMenu(t *testing.T) {
+	s := gotgbottest.NewServer()
+	defer s.Close()
+
+	b, err := s.NewBot()
+	if err != nil {
+		t.Fatalf("failed to create bot: %v", err)
+	}
+
+	menu, err := handlers.NewPaginatedMenu("items", func(b *gotgbot.Bot, ctx *ext.Context) ([]gotgbot.InlineKeyboardButton, error) {
+		items := make([]gotgbot.InlineKeyboardButton, 0, 5)
+		for i := 1; i <= 5; i++ {
+			items = append(items, gotgbot.InlineKeyboardButton{Text: strconv.Itoa(i), CallbackData: "item:" + strconv.Itoa(i)})
+		}
+		return items, nil
+	}, &handlers.PaginatedMenuOpts{PageSize: 2, Columns: 2})
+	if err != nil {
+		t.Fatalf("failed to create menu: %v", err)
+	}
+
+	d := ext.NewDispatcher(nil)
+	d.AddHandler(handlers.NewCommand("list", func(b *gotgbot.Bot, ctx *ext.Context) error {
+		_, err := menu.Send(b, ctx, "Items:", nil)
+		return err
+	}))
+	d.AddHandler(menu)
+
+	if err := d.ProcessUpdate(b, &gotgbot.Update{Message: &gotgbot.Message{
+		MessageId: 1,
+		Text:      "/list",
+		Entities:  []gotgbot.MessageEntity{{Type: "bot_command", Offset: 0, Length: 5}},
+		From:      &gotgbot.User{Id: 1},
+		Chat:      gotgbot.Chat{Id: 1, Type: "private"},
+	}}, nil); err != nil {
+		t.Fatalf("failed to process update: %v", err)
+	}
+
+	sent := s.CallsTo("sendMessage")
+	if len(sent) != 1 {
+		t.Fatalf("expected the menu to be sent, got %d calls", len(sent))
+	}
+	var markup gotgbot.InlineKeyboardMarkup
+	if err := sent[0].DecodeParam("reply_markup", &markup); err != nil {
+		t.Fatalf("failed to decode menu: %v", err)
+	}
+	expectMenuPage(t, markup, []string{"1", "2"}, []string{"1/3", "Next »"})
+
+	// Press "next" twice, to get to the last page.
+	for _, expected := range []struct {
+		items []string
+		nav   []string
+	}{
+		{items: []string{"3", "4"}, nav: []string{"« Previous", "2/3", "Next »"}},
+		{items: []string{"5"}, nav: []string{"« Previous", "3/3"}},
+	} {
+		nav := markup.InlineKeyboard[len(markup.InlineKeyboard)-1]
+		next := nav[len(nav)-1]
+
+		s.ResetCalls()
+		if err := d.ProcessUpdate(b, &gotgbot.Update{CallbackQuery: &gotgbot.CallbackQuery{
+			Id:      "cq",
+			From:    gotgbot.User{Id: 1},
+			Message: gotgbot.Message{MessageId: 10, Chat: gotgbot.Chat{Id: 1, Type: "private"}},
+			Data:    next.CallbackData,
+		}}, nil); err != nil {
+			t.Fatalf("failed to process update: %v", err)
+		}
+
+		edits := s.CallsTo("editMessageReplyMarkup")
+		if len(edits) != 1 {
+			t.Fatalf("expected the menu to be edited, got %d calls", len(edits))
+		}
+		if edits[0].Param("message_id") != "10" || edits[0].Param("chat_id") != "1" {
+			t.Errorf("expected the menu message to be edited, got %+v", edits[0].Params)
+		}
+		if len(s.CallsTo("answerCallbackQuery")) != 1 {
+			t.Errorf("expected the callback query to be answered")
+		}
+
+		markup = gotgbot.InlineKeyboardMarkup{}
+		if err := edits[0].DecodeParam("reply_markup", &markup); err != nil {
+			t.Fatalf("failed to decode menu: %v", err)
+		}
+		expectMenuPage(t, markup, expected.items, expected.nav)
+	}
+
+	// The page indicator only answers the callback query.
+	s.ResetCalls()
+	if err := d.ProcessUpdate(b, &gotgbot.Update{CallbackQuery: &gotgbot.CallbackQuery{
+		Id:      "cq",
+		From:    gotgbot.User{Id: 1},
+		Message: gotgbot.Message{MessageId: 10, Chat: gotgbot.Chat{Id: 1, Type: "private"}},
+		Data:    markup.InlineKeyboard[len(markup.InlineKeyboard)-1][1].CallbackData,
+	}}, nil); err != nil {
+		t.Fatalf("failed to process update: %v", err)
+	}
+	if len(s.CallsTo("editMessageReplyMarkup")) != 0 || len(s.CallsTo("answerCallbackQuery")) != 1 {
+		t.Errorf("expected the page indicator to only answer the callback query, got %+v", s.Calls())
+	}
+}
+
+func expectMenuPage(t *testing.T, markup gotgbot.InlineKeyboardMarkup, items []string, nav []string) {
+	t.Helper()
+
+	var gotItems []string
+	for _, row := range markup.InlineKeyboard[:len(markup.InlineKeyboard)-1] {
+		for _, b := range row {
+			gotItems = append(gotItems, b.Text)
+		}
+	}
+
+	var gotNav []string
+	for _, b := range markup.InlineKeyboard[len(markup.InlineKeyboard)-1] {
+		gotNav = append(gotNav, b.Text)
+	}
+
+	if !reflect.DeepEqual(gotItems, items) || !reflect.DeepEqual(gotNav, nav) {
+		t.Fatalf("expected items %v and navigation %v, got %v and %v", items, nav, gotItems, gotNav)
+	}
+}
+
+func TestPaginatedMenuSend(t *testing.T) {
+	s := gotgbottest.NewServer()
+	defer s.Close()
+
+	b, err := s.NewBot()
+	if err != nil {
+		t.Fatalf("failed to create bot: %v", err)
+	}
+
+	menu, err := handlers.NewPaginatedMenu("items", func(b *gotgbot.Bot, ctx *ext.Context) ([]gotgbot.InlineKeyboardButton, error) {
+		items := make([]gotgbot.InlineKeyboardButton, 0, 12)
+		for i := 1; i <= 12; i++ {
+			items = append(items, gotgbot.InlineKeyboardButton{Text: strconv.Itoa(i), CallbackData: "item:" + strconv.Itoa(i)})
+		}
+		return items, nil
+	}, nil)
+	if err != nil {
+		t.Fatalf("failed to create menu: %v", err)
+	}
+	// Invalid page sizes fall back to the default, rather than panicking.
+	menu.PageSize = 0
+
+	ctx := ext.NewContext(b, &gotgbot.Update{Message: &gotgbot.Message{
+		MessageId: 1,
+		Text:      "/list",
+		From:      &gotgbot.User{Id: 1},
+		Chat:      gotgbot.Chat{Id: 1, Type: "private"},
+	}}, nil)
+
+	opts := &gotgbot.SendMessageOpts{ReplyMarkup: gotgbot.ReplyKeyboardRemove{RemoveKeyboard: true}}
+	if _, err := menu.Send(b, ctx, "Items:", opts); err != nil {
+		t.Fatalf("failed to send menu: %v", err)
+	}
+	if _, ok := opts.ReplyMarkup.(gotgbot.ReplyKeyboardRemove); !ok {
+		t.Errorf("expected the caller's opts to be left unchanged, got %+v", opts.ReplyMarkup)
+	}
+
+	sent := s.CallsTo("sendMessage")
+	if len(sent) != 1 {
+		t.Fatalf("expected the menu to be sent, got %d calls", len(sent))
+	}
+	var markup gotgbot.InlineKeyboardMarkup
+	if err := sent[0].DecodeParam("reply_markup", &markup); err != nil {
+		t.Fatalf("failed to decode menu: %v", err)
+	}
+	expectMenuPage(t, markup, []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"}, []string{"1/2", "Next »"})
+
+	// Inline queries don't have a chat to send the menu to.
+	ctx = ext.NewContext(b, &gotgbot.Update{InlineQuery: &gotgbot.InlineQuery{Id: "iq", From: gotgbot.User{Id: 1}}}, nil)
+	if _, err := menu.Send(b, ctx, "Items:", nil); !errors.Is(err, handlers.ErrNoMenuChat) {
+		t.Errorf("expected a missing chat error, got: %v", err)
+	}
+}
@@ -0,0 +1,26 @@
+package ext
+
+import (
+	"github.com/PaulSonOfLars/gotgbot/v2"
+)
+
+// HandlerFunc is the signature of the Handler.HandleUpdate method, used to process an update.
+type HandlerFunc func(b *gotgbot.Bot, ctx *Context) error
+
+// Middleware wraps the processing of an update by a handler, allowing for shared logic (eg, logging, auth checks,
+// metrics) to be written once, rather than in every handler.
+//
+// Middleware is only called once a handler's CheckUpdate method has matched; the current handler is available from
+// Context.Handler. A middleware can short-circuit the handler by returning without calling next. The returned error is
+// treated as if it was returned by the handler itself; so returning ContinueGroups allows for the next handler in the
+// group to be checked, and returning nil marks the update as handled for that group.
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// ChainMiddleware wraps the HandlerFunc with the given middleware. The first middleware is the outermost one, and is
+// therefore the first to be called.
+func ChainMiddleware(h HandlerFunc, middleware ...Middleware) HandlerFunc {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		h = middleware[i](h)
+	}
+	return h
+}
@@ -0,0 +1,321 @@
+package ext
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/PaulSonOfLars/gotgbot/v2/internal/atomicfile"
+)
+
+var ErrUnacknowledgedDispatcher = errors.New("dispatcher does not support update acknowledgements")
+
+// OffsetStore persists the long polling progress of each bot, such that polling can resume where it left off after a
+// restart.
+type OffsetStore interface {
+	// LastUpdateId returns the ID of the last fully processed update for the bot; or 0 if there are none.
+	LastUpdateId(b *gotgbot.Bot) (int64, error)
+	// SetLastUpdateId records the ID of the last fully processed update for the bot.
+	// All updates with a lower ID are guaranteed to have been processed as well.
+	SetLastUpdateId(b *gotgbot.Bot, updateId int64) error
+}
+
+var (
+	// Ensure the offset stores implement the OffsetStore interface.
+	_ OffsetStore = &InMemoryOffsetStore{}
+	_ OffsetStore = &FileOffsetStore{}
+)
+
+// InMemoryOffsetStore is a thread-safe implementation of the OffsetStore interface, which keeps all offsets in memory.
+// This allows for offsets to be kept when stopping and restarting a bot within the same process.
+type InMemoryOffsetStore struct {
+	// offsets maps each bot ID to its last processed update ID.
+	offsets map[int64]int64
+	// lock allows for concurrent access.
+	lock sync.RWMutex
+}
+
+// NewInMemoryOffsetStore creates a new, empty, InMemoryOffsetStore.
+func NewInMemoryOffsetStore() *InMemoryOffsetStore {
+	return &InMemoryOffsetStore{
+		offsets: map[int64]int64{},
+	}
+}
+
+func (s *InMemoryOffsetStore) LastUpdateId(b *gotgbot.Bot) (int64, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.offsets[b.Id], nil
+}
+
+func (s *InMemoryOffsetStore) SetLastUpdateId(b *gotgbot.Bot, updateId int64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.offsets[b.Id] = updateId
+	return nil
+}
+
+// FileOffsetStore is a thread-safe implementation of the OffsetStore interface, which persists the offsets of all bots
+// to a single JSON file on disk. The file is rewritten atomically on every change.
+type FileOffsetStore struct {
+	// path is the location of the file used to persist the offsets.
+	path string
+	// offsets maps each bot ID to its last processed update ID.
+	offsets map[string]int64
+	// lock allows us to ensure synchronous data and file access.
+	lock sync.RWMutex
+}
+
+// NewFileOffsetStore creates a new FileOffsetStore, loading any existing offsets from the file at the given path.
+// The file is created on the first write if it does not exist yet.
+func NewFileOffsetStore(path string) (*FileOffsetStore, error) {
+	offsets := map[string]int64{}
+
+	bs, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read offset file: %w", err)
+	}
+
+	if len(bs) > 0 {
+		if err := json.Unmarshal(bs, &offsets); err != nil {
+			return nil, fmt.Errorf("failed to decode offset file: %w", err)
+		}
+	}
+
+	return &FileOffsetStore{
+		path:    path,
+		offsets: offsets,
+	}, nil
+}
+
+func (s *FileOffsetStore) LastUpdateId(b *gotgbot.Bot) (int64, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.offsets[strconv.FormatInt(b.Id, 10)], nil
+}
+
+func (s *FileOffsetStore) SetLastUpdateId(b *gotgbot.Bot, updateId int64) error {
+	key := strconv.FormatInt(b.Id, 10)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	prev, existed := s.offsets[key]
+	s.offsets[key] = updateId
+
+	if err := s.save(); err != nil {
+		// Revert the in-memory change, to remain consistent with what is on disk.
+		if existed {
+			s.offsets[key] = prev
+		} else {
+			delete(s.offsets, key)
+		}
+		return err
+	}
+	return nil
+}
+
+// save writes all offsets to disk, without risking a corrupted file if the bot crashes halfway.
+// Must be called with the write lock held.
+func (s *FileOffsetStore) save() error {
+	bs, err := json.Marshal(s.offsets)
+	if err != nil {
+		return fmt.Errorf("failed to encode offsets: %w", err)
+	}
+
+	if err := atomicfile.WriteFile(s.path, bs); err != nil {
+		return fmt.Errorf("failed to save offset file: %w", err)
+	}
+	return nil
+}
+
+// offsetFlushInterval is how often the offset trackers record their progress in their OffsetStore.
+const offsetFlushInterval = time.Second
+
+// offsetTracker keeps track of the updates sent to the dispatcher by a polling bot, such that only the updates which
+// have been fully processed are recorded in the OffsetStore and acknowledged to telegram.
+//
+// To avoid slowing down every handler with a (potentially slow) store write, the progress is recorded in the store in
+// the background, every offsetFlushInterval; as well as once the tracker is closed.
+type offsetTracker struct {
+	// bot is the bot whose updates are being tracked.
+	bot *gotgbot.Bot
+	// store is where the last processed update ID is recorded.
+	store OffsetStore
+	// errFunc is called when the store fails to record an update ID.
+	errFunc ErrorFunc
+
+	// mux ensures the tracker is concurrency-safe.
+	mux sync.Mutex
+	// pending contains the IDs of the updates which have been sent to the dispatcher, but not yet processed.
+	pending map[int64]struct{}
+	// lastSent is the highest update ID which has been sent to the dispatcher.
+	lastSent int64
+	// lastProcessed is the highest update ID such that it, and all the updates before it, have been processed.
+	lastProcessed int64
+	// progress is signalled whenever lastProcessed increases.
+	progress chan struct{}
+	// closed is true once the tracker has been closed; any later progress is recorded in the store straight away.
+	closed bool
+	// done is closed when the tracker is closed, to stop the background flushes.
+	done chan struct{}
+
+	// flushMux ensures that only one flush writes to the store at a time, without holding up the tracker.
+	flushMux sync.Mutex
+	// lastStored is the last update ID recorded in the store. Protected by flushMux.
+	lastStored int64
+}
+
+func newOffsetTracker(b *gotgbot.Bot, store OffsetStore, lastProcessed int64, errFunc ErrorFunc) *offsetTracker {
+	t := &offsetTracker{
+		bot:           b,
+		store:         store,
+		errFunc:       errFunc,
+		pending:       map[int64]struct{}{},
+		lastSent:      lastProcessed,
+		lastProcessed: lastProcessed,
+		progress:      make(chan struct{}, 1),
+		done:          make(chan struct{}),
+		lastStored:    lastProcessed,
+	}
+	go t.flushLoop(offsetFlushInterval)
+	return t
+}
+
+// send marks an update as being sent to the dispatcher. If the update has already been sent, false is returned, and
+// the update should be skipped.
+func (t *offsetTracker) send(updateId int64) bool {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	if updateId <= t.lastSent {
+		return false
+	}
+	t.pending[updateId] = struct{}{}
+	t.lastSent = updateId
+	return true
+}
+
+// restart forgets all the tracked updates, such that tracking restarts from the given update ID. This is needed when
+// telegram restarts its update IDs, which it does after about a week without any updates: the new IDs can be lower
+// than the ones which were already processed, and would otherwise be skipped forever.
+func (t *offsetTracker) restart(updateId int64) {
+	t.mux.Lock()
+	// Any updates still being processed belong to the old sequence; their acks are ignored.
+	t.pending = map[int64]struct{}{}
+	t.lastSent = updateId - 1
+	t.lastProcessed = updateId - 1
+	t.mux.Unlock()
+
+	// Make sure the outdated offset doesn't remain in the store.
+	t.flush()
+}
+
+// ack marks an update as processed.
+func (t *offsetTracker) ack(updateId int64) {
+	t.mux.Lock()
+
+	if _, ok := t.pending[updateId]; !ok {
+		t.mux.Unlock()
+		return
+	}
+	delete(t.pending, updateId)
+
+	// All updates up to the lowest pending update have been processed.
+	lastProcessed := t.lastSent
+	for id := range t.pending {
+		if id <= lastProcessed {
+			lastProcessed = id - 1
+		}
+	}
+	if lastProcessed <= t.lastProcessed {
+		t.mux.Unlock()
+		return
+	}
+	t.lastProcessed = lastProcessed
+
+	select {
+	case t.progress <- struct{}{}:
+	default:
+		// Progress has already been signalled.
+	}
+
+	closed := t.closed
+	t.mux.Unlock()
+
+	if closed {
+		// There are no more background flushes; record the progress straight away.
+		t.flush()
+	}
+}
+
+// nextOffset returns the offset to use when getting updates, which acknowledges all processed updates.
+func (t *offsetTracker) nextOffset() int64 {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	if t.lastProcessed == 0 {
+		return 0
+	}
+	return t.lastProcessed + 1
+}
+
+// flushLoop records the progress in the store at regular intervals, until the tracker is closed.
+func (t *offsetTracker) flushLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			t.flush()
+		case <-t.done:
+			return
+		}
+	}
+}
+
+// flush records the last processed update ID in the store, if it has changed since the last flush.
+func (t *offsetTracker) flush() {
+	t.flushMux.Lock()
+	defer t.flushMux.Unlock()
+
+	t.mux.Lock()
+	lastProcessed := t.lastProcessed
+	t.mux.Unlock()
+
+	if lastProcessed == t.lastStored {
+		return
+	}
+
+	if err := t.store.SetLastUpdateId(t.bot, lastProcessed); err != nil {
+		// The next flush will try again.
+		if t.errFunc != nil {
+			t.errFunc(fmt.Errorf("failed to store last processed update ID: %w", err))
+		}
+		return
+	}
+	t.lastStored = lastProcessed
+}
+
+// close stops the background flushes, and records the current progress in the store. Any updates processed after
+// this are recorded straight away.
+func (t *offsetTracker) close() {
+	t.mux.Lock()
+	if !t.closed {
+		t.closed = true
+		close(t.done)
+	}
+	t.mux.Unlock()
+
+	t.flush()
+}
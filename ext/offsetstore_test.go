@@ -0,0 +1,364 @@
+package ext_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers/filters/message"
+)
+
+func TestFileOffsetStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "offsets.json")
+	b := &gotgbot.Bot{User: gotgbot.User{Id: 1}}
+
+	s, err := ext.NewFileOffsetStore(path)
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+	if id, err := s.LastUpdateId(b); err != nil || id != 0 {
+		t.Fatalf("expected no update ID, got %d (err: %v)", id, err)
+	}
+	if err := s.SetLastUpdateId(b, 42); err != nil {
+		t.Fatalf("failed to set update ID: %v", err)
+	}
+
+	// A new store should load the previously stored offsets.
+	s, err = ext.NewFileOffsetStore(path)
+	if err != nil {
+		t.Fatalf("failed to reload store: %v", err)
+	}
+	if id, err := s.LastUpdateId(b); err != nil || id != 42 {
+		t.Fatalf("expected update ID 42, got %d (err: %v)", id, err)
+	}
+	if id, err := s.LastUpdateId(&gotgbot.Bot{User: gotgbot.User{Id: 2}}); err != nil || id != 0 {
+		t.Fatalf("expected no update ID for other bot, got %d (err: %v)", id, err)
+	}
+}
+
+// offsetTestServer serves the updates with IDs 1 to numUpdates, from the requested offset; and records all requested
+// offsets.
+func offsetTestServer(t *testing.T, numUpdates int64) (*httptest.Server, func() []string) {
+	mux := sync.Mutex{}
+	var offsets []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/getUpdates") {
+			t.Errorf("unexpected API call to %s", r.URL.Path)
+			return
+		}
+
+		params := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+			t.Errorf("failed to decode request params: %v", err)
+		}
+
+		mux.Lock()
+		offsets = append(offsets, params["offset"])
+		mux.Unlock()
+
+		offset, _ := strconv.ParseInt(params["offset"], 10, 64)
+		var updates []string
+		for id := offset; id <= numUpdates; id++ {
+			if id > 0 {
+				updates = append(updates, fmt.Sprintf(`{"update_id": %d, "message": {"text": "%d"}}`, id, id))
+			}
+		}
+		if len(updates) == 0 {
+			time.Sleep(time.Millisecond * 10)
+		}
+		fmt.Fprintf(w, `{"ok": true, "result": [%s]}`, strings.Join(updates, ","))
+	}))
+
+	return srv, func() []string {
+		mux.Lock()
+		defer mux.Unlock()
+		return append([]string(nil), offsets...)
+	}
+}
+
+func TestUpdaterOffsetStoreOnlyAcknowledgesProcessedUpdates(t *testing.T) {
+	server, _ := offsetTestServer(t, 3)
+	defer server.Close()
+
+	reqOpts := &gotgbot.RequestOpts{APIURL: server.URL}
+	b := &gotgbot.Bot{
+		User:      gotgbot.User{Id: 1},
+		Token:     "SOME_TOKEN",
+		BotClient: &gotgbot.BaseBotClient{DefaultRequestOpts: reqOpts},
+	}
+
+	d := ext.NewDispatcher(nil)
+	u := ext.NewUpdater(d, nil)
+
+	release := make(chan struct{})
+	processed := make(chan string, 10)
+	d.AddHandler(handlers.NewMessage(message.Text, func(b *gotgbot.Bot, ctx *ext.Context) error {
+		if ctx.EffectiveMessage.Text == "2" {
+			<-release
+		}
+		processed <- ctx.EffectiveMessage.Text
+		return nil
+	}))
+
+	store := ext.NewInMemoryOffsetStore()
+	err := u.StartPolling(b, &ext.PollingOpts{
+		GetUpdatesOpts: &gotgbot.GetUpdatesOpts{RequestOpts: reqOpts},
+		OffsetStore:    store,
+	})
+	if err != nil {
+		t.Fatalf("failed to start polling: %v", err)
+	}
+
+	// Updates 1 and 3 are processed; 2 is still pending.
+	seen := map[string]bool{}
+	for len(seen) < 2 {
+		seen[<-processed] = true
+	}
+	if !seen["1"] || !seen["3"] {
+		t.Fatalf("expected updates 1 and 3 to be processed, got %v", seen)
+	}
+	// Update 1 is acknowledged once its handler returns; but 3 must not be, while 2 is pending.
+	waitForLastUpdateId(t, store, b, 1)
+	time.Sleep(time.Millisecond * 50)
+	if id, _ := store.LastUpdateId(b); id != 1 {
+		t.Errorf("expected last processed update to be 1 while 2 is pending, got %d", id)
+	}
+
+	close(release)
+	if upd := <-processed; upd != "2" {
+		t.Errorf("expected update 2 to be processed, got %s", upd)
+	}
+
+	if err := u.Stop(); err != nil {
+		t.Fatalf("failed to stop updater: %v", err)
+	}
+	if id, _ := store.LastUpdateId(b); id != 3 {
+		t.Errorf("expected last processed update to be 3, got %d", id)
+	}
+
+	// Duplicate updates returned while update 2 was pending should not have been processed again.
+	select {
+	case upd := <-processed:
+		t.Errorf("unexpected duplicate processing of update %s", upd)
+	default:
+	}
+}
+
+func TestUpdaterOffsetStoreResumesPolling(t *testing.T) {
+	server, offsets := offsetTestServer(t, 0)
+	defer server.Close()
+
+	reqOpts := &gotgbot.RequestOpts{APIURL: server.URL}
+	b := &gotgbot.Bot{
+		User:      gotgbot.User{Id: 1},
+		Token:     "SOME_TOKEN",
+		BotClient: &gotgbot.BaseBotClient{DefaultRequestOpts: reqOpts},
+	}
+
+	store := ext.NewInMemoryOffsetStore()
+	if err := store.SetLastUpdateId(b, 10); err != nil {
+		t.Fatalf("failed to set update ID: %v", err)
+	}
+
+	u := ext.NewUpdater(ext.NewDispatcher(nil), nil)
+	err := u.StartPolling(b, &ext.PollingOpts{
+		GetUpdatesOpts: &gotgbot.GetUpdatesOpts{RequestOpts: reqOpts},
+		OffsetStore:    store,
+	})
+	if err != nil {
+		t.Fatalf("failed to start polling: %v", err)
+	}
+
+	for len(offsets()) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	if err := u.Stop(); err != nil {
+		t.Fatalf("failed to stop updater: %v", err)
+	}
+
+	if first := offsets()[0]; first != "11" {
+		t.Errorf("expected polling to resume from offset 11, got %q", first)
+	}
+}
+
+func waitForLastUpdateId(t *testing.T, store ext.OffsetStore, b *gotgbot.Bot, expected int64) {
+	t.Helper()
+
+	timeout := time.After(time.Second * 5)
+	for {
+		id, err := store.LastUpdateId(b)
+		if err != nil {
+			t.Fatalf("failed to get last update ID: %v", err)
+		}
+		if id == expected {
+			return
+		}
+
+		select {
+		case <-timeout:
+			t.Fatalf("expected last processed update to be %d, got %d", expected, id)
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func TestUpdaterOffsetStoreHandlesRestartedUpdateIds(t *testing.T) {
+	mux := sync.Mutex{}
+	var offsets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		params := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+			t.Errorf("failed to decode request params: %v", err)
+		}
+
+		mux.Lock()
+		offsets = append(offsets, params["offset"])
+		mux.Unlock()
+
+		// Telegram has restarted its update IDs, so the stored offset is higher than any of the new updates.
+		offset, _ := strconv.ParseInt(params["offset"], 10, 64)
+		if offset > 100 {
+			offset = 0
+		}
+		var updates []string
+		for id := offset; id <= 3; id++ {
+			if id > 0 {
+				updates = append(updates, fmt.Sprintf(`{"update_id": %d, "message": {"text": "%d"}}`, id, id))
+			}
+		}
+		if len(updates) == 0 {
+			time.Sleep(time.Millisecond * 10)
+		}
+		fmt.Fprintf(w, `{"ok": true, "result": [%s]}`, strings.Join(updates, ","))
+	}))
+	defer server.Close()
+
+	reqOpts := &gotgbot.RequestOpts{APIURL: server.URL}
+	b := &gotgbot.Bot{
+		User:      gotgbot.User{Id: 1},
+		Token:     "SOME_TOKEN",
+		BotClient: &gotgbot.BaseBotClient{DefaultRequestOpts: reqOpts},
+	}
+
+	store := ext.NewInMemoryOffsetStore()
+	if err := store.SetLastUpdateId(b, 1000); err != nil {
+		t.Fatalf("failed to set update ID: %v", err)
+	}
+
+	d := ext.NewDispatcher(nil)
+	u := ext.NewUpdater(d, nil)
+
+	processed := make(chan string, 10)
+	d.AddHandler(handlers.NewMessage(message.Text, func(b *gotgbot.Bot, ctx *ext.Context) error {
+		processed <- ctx.EffectiveMessage.Text
+		return nil
+	}))
+
+	err := u.StartPolling(b, &ext.PollingOpts{
+		GetUpdatesOpts: &gotgbot.GetUpdatesOpts{RequestOpts: reqOpts},
+		OffsetStore:    store,
+	})
+	if err != nil {
+		t.Fatalf("failed to start polling: %v", err)
+	}
+
+	seen := map[string]bool{}
+	for len(seen) < 3 {
+		seen[<-processed] = true
+	}
+	waitForLastUpdateId(t, store, b, 3)
+
+	if err := u.Stop(); err != nil {
+		t.Fatalf("failed to stop updater: %v", err)
+	}
+
+	select {
+	case upd := <-processed:
+		t.Errorf("unexpected duplicate processing of update %s", upd)
+	default:
+	}
+
+	mux.Lock()
+	defer mux.Unlock()
+	if offsets[0] != "1001" {
+		t.Errorf("expected polling to start from the stored offset, got %q", offsets[0])
+	}
+	if last := offsets[len(offsets)-1]; last != "4" {
+		t.Errorf("expected polling to continue from the new update IDs, got %q", last)
+	}
+}
+
+// countingOffsetStore counts the writes to the wrapped OffsetStore.
+type countingOffsetStore struct {
+	ext.OffsetStore
+
+	mux    sync.Mutex
+	writes int
+}
+
+func (s *countingOffsetStore) SetLastUpdateId(b *gotgbot.Bot, updateId int64) error {
+	s.mux.Lock()
+	s.writes++
+	s.mux.Unlock()
+	return s.OffsetStore.SetLastUpdateId(b, updateId)
+}
+
+func TestUpdaterOffsetStoreBatchesWrites(t *testing.T) {
+	const numUpdates = 50
+
+	server, _ := offsetTestServer(t, numUpdates)
+	defer server.Close()
+
+	reqOpts := &gotgbot.RequestOpts{APIURL: server.URL}
+	b := &gotgbot.Bot{
+		User:      gotgbot.User{Id: 1},
+		Token:     "SOME_TOKEN",
+		BotClient: &gotgbot.BaseBotClient{DefaultRequestOpts: reqOpts},
+	}
+
+	d := ext.NewDispatcher(nil)
+	u := ext.NewUpdater(d, nil)
+
+	processed := make(chan struct{}, numUpdates)
+	d.AddHandler(handlers.NewMessage(message.Text, func(b *gotgbot.Bot, ctx *ext.Context) error {
+		processed <- struct{}{}
+		return nil
+	}))
+
+	store := &countingOffsetStore{OffsetStore: ext.NewInMemoryOffsetStore()}
+	err := u.StartPolling(b, &ext.PollingOpts{
+		GetUpdatesOpts: &gotgbot.GetUpdatesOpts{RequestOpts: reqOpts},
+		OffsetStore:    store,
+	})
+	if err != nil {
+		t.Fatalf("failed to start polling: %v", err)
+	}
+
+	for i := 0; i < numUpdates; i++ {
+		<-processed
+	}
+	if err := u.Stop(); err != nil {
+		t.Fatalf("failed to stop updater: %v", err)
+	}
+
+	// The final progress is recorded when stopping, but the store isn't written for every single update.
+	if id, _ := store.LastUpdateId(b); id != numUpdates {
+		t.Errorf("expected last processed update to be %d, got %d", numUpdates, id)
+	}
+	store.mux.Lock()
+	defer store.mux.Unlock()
+	if store.writes >= numUpdates/2 {
+		t.Errorf("expected the store writes to be batched, got %d writes for %d updates", store.writes, numUpdates)
+	}
+}
@@ -24,6 +24,11 @@ var (
 
 type ErrorFunc func(error)
 
+// contextStopper is implemented by UpdateDispatchers which can be stopped with a deadline, such as the Dispatcher.
+type contextStopper interface {
+	StopWithContext(ctx context.Context) error
+}
+
 type Updater struct {
 	// Dispatcher is where all the incoming updates are sent to be processed.
 	// The Dispatcher runs in a separate goroutine, allowing for parallel update processing and dispatching.
@@ -107,6 +112,27 @@ type PollingOpts struct {
 	//    long-polling, Telegram responds to your request as soon as new messages are available.
 	//    When setting this, it is recommended you set your PollingOpts.Timeout value to be slightly bigger (eg, +1).
 	GetUpdatesOpts *gotgbot.GetUpdatesOpts
+	// OffsetStore enables durable tracking of the polling offset. The ID of the last fully processed update is recorded
+	// in the store, and polling resumes from that point when restarted. Updates are only acknowledged to telegram once
+	// they have been processed; so if the bot crashes, unprocessed updates are received again (at-least-once delivery).
+	// To keep slow stores from holding up the handlers, the store is written in the background, at most once per
+	// second, and one last time when the bot is stopped.
+	// This requires the Updater's Dispatcher to implement AcknowledgingDispatcher; see its documentation for how slow
+	// handlers delay acknowledgements.
+	// If nil, updates are acknowledged as soon as they have been sent to the Dispatcher.
+	OffsetStore OffsetStore
+}
+
+// pollingState keeps track of the long polling progress of a bot.
+// It is only modified by the polling loop; once the loop has exited, it is safe to read.
+type pollingState struct {
+	// reqOpts are the request options used for the getUpdates calls.
+	reqOpts *gotgbot.RequestOpts
+	// offset is the ID of the first update which has not been sent to the dispatcher yet.
+	// This is not used when an OffsetStore is set.
+	offset int64
+	// tracker keeps track of which updates have been processed, when an OffsetStore is set.
+	tracker *offsetTracker
 }
 
 // StartPolling starts polling updates from telegram using getUpdates long-polling.
@@ -157,12 +183,56 @@ func (u *Updater) StartPolling(b *gotgbot.Bot, opts *PollingOpts) error {
 		}
 	}
 
+	var tracker *offsetTracker
+	var ackDispatcher AcknowledgingDispatcher
+	if opts != nil && opts.OffsetStore != nil {
+		var ok bool
+		ackDispatcher, ok = u.Dispatcher.(AcknowledgingDispatcher)
+		if !ok {
+			return ErrUnacknowledgedDispatcher
+		}
+
+		lastUpdateId, err := opts.OffsetStore.LastUpdateId(b)
+		if err != nil {
+			return fmt.Errorf("failed to get last processed update ID: %w", err)
+		}
+		if offset, ok := v["offset"]; ok {
+			// An explicit offset takes precedence over the stored one.
+			explicitOffset, err := strconv.ParseInt(offset, 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse offset: %w", err)
+			}
+			lastUpdateId = explicitOffset - 1
+		}
+
+		tracker = newOffsetTracker(b, opts.OffsetStore, lastUpdateId, func(err error) {
+			if u.UnhandledErrFunc != nil {
+				u.UnhandledErrFunc(err)
+			} else {
+				u.logf("Failed to track update offset: %s", err.Error())
+			}
+		})
+	}
+
 	bData, err := u.botMapping.addBot(b, "", "")
 	if err != nil {
+		if tracker != nil {
+			tracker.close()
+		}
 		return fmt.Errorf("failed to add bot with long polling: %w", err)
 	}
 
-	go u.Dispatcher.Start(b, bData.updateChan)
+	bData.polling.reqOpts = reqOpts
+	bData.polling.tracker = tracker
+	if opts != nil && opts.GetUpdatesOpts != nil {
+		bData.polling.offset = opts.GetUpdatesOpts.Offset
+	}
+
+	if tracker != nil {
+		go ackDispatcher.StartWithAck(b, bData.updateChan, tracker.ack)
+	} else {
+		go u.Dispatcher.Start(b, bData.updateChan)
+	}
 	go u.pollingLoop(bData, reqOpts, v)
 
 	return nil
@@ -172,16 +242,31 @@ func (u *Updater) pollingLoop(bData *botData, opts *gotgbot.RequestOpts, v map[s
 	bData.updateWriterControl.Add(1)
 	defer bData.updateWriterControl.Done()
 
+	tracker := bData.polling.tracker
+
 	for {
 		// Check if updater loop has been terminated.
 		if bData.shouldStopUpdates() {
 			return
 		}
 
+		var requestedOffset int64
+		if tracker != nil {
+			// Only acknowledge the updates which have been processed.
+			if requestedOffset = tracker.nextOffset(); requestedOffset != 0 {
+				v["offset"] = strconv.FormatInt(requestedOffset, 10)
+			}
+		}
+
 		// Manually craft the getUpdate calls to improve memory management, reduce json parsing overheads, and
 		// unnecessary reallocation of url.Values in the polling loop.
-		r, err := bData.bot.Request("getUpdates", v, nil, opts)
+		r, err := bData.bot.RequestWithContext(bData.ctx, "getUpdates", v, nil, opts)
 		if err != nil {
+			if bData.shouldStopUpdates() {
+				// The request was cancelled because the bot was stopped.
+				return
+			}
+
 			if u.UnhandledErrFunc != nil {
 				u.UnhandledErrFunc(err)
 			} else {
@@ -208,6 +293,13 @@ func (u *Updater) pollingLoop(bData *botData, opts *gotgbot.RequestOpts, v map[s
 			continue
 		}
 
+		if tracker != nil {
+			if !u.sendTrackedUpdates(bData, tracker, requestedOffset, rawUpdates) {
+				return
+			}
+			continue
+		}
+
 		var lastUpdate struct {
 			UpdateId int64 `json:"update_id"`
 		}
@@ -226,9 +318,67 @@ func (u *Updater) pollingLoop(bData *botData, opts *gotgbot.RequestOpts, v map[s
 
 		for _, updData := range rawUpdates {
 			temp := updData // use new mem address to avoid loop conflicts
-			bData.updateChan <- temp
+			select {
+			case bData.updateChan <- temp:
+			case <-bData.stopUpdates:
+				// The bot was stopped before this update could be sent; make sure it gets fetched again on restart.
+				var unsent struct {
+					UpdateId int64 `json:"update_id"`
+				}
+				if err := json.Unmarshal(temp, &unsent); err == nil {
+					bData.polling.offset = unsent.UpdateId
+				}
+				return
+			}
+		}
+		bData.polling.offset = lastUpdate.UpdateId + 1
+	}
+}
+
+// sendTrackedUpdates sends any updates which haven't been sent yet to the dispatcher. Since unprocessed updates are not
+// acknowledged, telegram keeps sending them; if none of the updates are new, this waits for some of the pending updates
+// to be processed, to avoid needlessly polling in a loop.
+// Returns false if the bot was stopped.
+func (u *Updater) sendTrackedUpdates(bData *botData, tracker *offsetTracker, requestedOffset int64, rawUpdates []json.RawMessage) bool {
+	// Telegram never returns updates below the requested offset, unless it has restarted its update IDs (which happens
+	// after about a week without any updates). The tracked progress is then meaningless; start over from the new IDs.
+	for _, updData := range rawUpdates {
+		if id, ok := rawUpdateId(updData); ok {
+			if requestedOffset != 0 && id < requestedOffset {
+				tracker.restart(id)
+			}
+			break
+		}
+	}
+
+	sent := 0
+	for _, updData := range rawUpdates {
+		id, ok := rawUpdateId(updData)
+		if !ok || !tracker.send(id) {
+			// Invalid, or already sent.
+			continue
+		}
+
+		temp := updData // use new mem address to avoid loop conflicts
+		select {
+		case bData.updateChan <- temp:
+			sent++
+		case <-bData.stopUpdates:
+			// The bot was stopped; the update will be received again on restart, since it won't be acknowledged.
+			return false
+		}
+	}
+
+	if sent == 0 {
+		select {
+		case <-tracker.progress:
+		case <-bData.stopUpdates:
+			return false
+		case <-time.After(time.Second):
+			// Poll again anyway, in case the pending updates can't be tracked.
 		}
 	}
+	return true
 }
 
 // Idle starts an infinite loop to avoid the program exciting while the background threads handle updates.
@@ -242,8 +392,9 @@ func (u *Updater) Idle() {
 
 // Stop stops the current updater and dispatcher instances.
 //
-// When using long polling, Stop() will wait for the getUpdates call to return, which may cause a delay due to the
-// request timeout.
+// When using long polling, any in-flight getUpdates call is cancelled. Stop doesn't make any requests to telegram, so
+// the updates received by the last getUpdates call are received again on restart; use StopWithContext to confirm
+// them, or PollingOpts.OffsetStore to keep track of exactly which updates were processed.
 func (u *Updater) Stop() error {
 	// Stop any running servers.
 	if u.webhookServer != nil {
@@ -254,15 +405,114 @@ func (u *Updater) Stop() error {
 	}
 
 	// Close all existing bot channels.
-	u.StopAllBots()
+	bots := u.stopAllBots()
 
 	// Stop the dispatcher from processing any further updates.
 	u.Dispatcher.Stop()
 
+	// Record the progress of the processed updates.
+	closeOffsetTrackers(bots)
+
 	// Finally, atop idling.
+	u.stopIdle()
+	return nil
+}
+
+// StopWithContext gracefully stops the current updater and dispatcher instances.
+//
+// Any in-flight getUpdates calls are cancelled, and the webhook server stops accepting new requests. In-flight
+// updates are then given until the context is done to finish processing, after which their context is cancelled. If
+// any updates were abandoned, an AbandonedUpdatesError containing their IDs is returned.
+// When using long polling, if all updates were processed in time, the offset of the last update is then confirmed with
+// telegram (using one last getUpdates request, within the same context), such that they are not received again on
+// restart. If any updates were abandoned, the context is already done, so nothing is confirmed: all the updates
+// received by the last getUpdates call are received again on restart, including those which were processed. Use
+// PollingOpts.OffsetStore to keep track of exactly which updates were processed instead.
+func (u *Updater) StopWithContext(ctx context.Context) error {
+	var stopErr error
+
+	// Stop any running servers; this allows for any in-flight webhook requests to be passed to the dispatcher.
+	if u.webhookServer != nil {
+		err := u.webhookServer.Shutdown(ctx)
+		if err != nil {
+			// Some requests are still hanging; forcibly close them.
+			_ = u.webhookServer.Close()
+			stopErr = fmt.Errorf("failed to shutdown server: %w", err)
+		}
+	}
+
+	// Close all existing bot channels.
+	bots := u.stopAllBots()
+
+	// Stop the dispatcher, giving it until the context is done to process the remaining updates.
+	var err error
+	if d, ok := u.Dispatcher.(contextStopper); ok {
+		err = d.StopWithContext(ctx)
+	} else {
+		done := make(chan struct{})
+		go func() {
+			u.Dispatcher.Stop()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-ctx.Done():
+			err = fmt.Errorf("failed to stop dispatcher: %w", ctx.Err())
+		}
+	}
+
+	// Record the progress of the processed updates, even if some were abandoned.
+	closeOffsetTrackers(bots)
+
+	if err == nil {
+		// All updates have been processed, so we can commit the offsets. If any updates were abandoned, the context is
+		// already done, so there is no time left to do so.
+		err = u.commitOffsets(ctx, bots)
+	}
+	if stopErr == nil {
+		stopErr = err
+	}
+
+	// Finally, stop idling.
+	u.stopIdle()
+	return stopErr
+}
+
+func (u *Updater) stopIdle() {
 	if u.stopIdling != nil {
 		close(u.stopIdling)
 	}
+}
+
+// commitOffsets confirms the processed updates of all polling bots with telegram.
+func (u *Updater) commitOffsets(ctx context.Context, bots []botData) error {
+	for _, bData := range bots {
+		if bData.polling == nil {
+			// Webhook bot; nothing to commit.
+			continue
+		}
+
+		offset := bData.polling.offset
+		if bData.polling.tracker != nil {
+			// The tracker knows exactly which updates have been processed.
+			offset = bData.polling.tracker.nextOffset()
+		}
+		if offset == 0 {
+			// No updates have been received.
+			continue
+		}
+
+		// Calling getUpdates with an offset confirms all the previous updates. We don't care about the result.
+		_, err := bData.bot.RequestWithContext(ctx, "getUpdates", map[string]string{
+			"offset":  strconv.FormatInt(offset, 10),
+			"limit":   "1",
+			"timeout": "0",
+		}, nil, bData.polling.reqOpts)
+		if err != nil {
+			return fmt.Errorf("failed to commit update offset for bot %d: %w", bData.bot.Id, err)
+		}
+	}
 	return nil
 }
 
@@ -273,13 +523,30 @@ func (u *Updater) StopBot(token string) bool {
 	}
 
 	bData.stop()
+	closeOffsetTrackers([]botData{bData})
 	return true
 }
 
 func (u *Updater) StopAllBots() {
-	for _, bData := range u.botMapping.removeAllBots() {
+	u.stopAllBots()
+}
+
+// closeOffsetTrackers records the final progress of any polling bots which use an OffsetStore.
+func closeOffsetTrackers(bots []botData) {
+	for _, bData := range bots {
+		if bData.polling != nil && bData.polling.tracker != nil {
+			bData.polling.tracker.close()
+		}
+	}
+}
+
+// stopAllBots stops all bots, and returns their data.
+func (u *Updater) stopAllBots() []botData {
+	bots := u.botMapping.removeAllBots()
+	for _, bData := range bots {
 		bData.stop()
 	}
+	return bots
 }
 
 // StartWebhook starts the webhook server for a single bot instance.
@@ -590,3 +590,198 @@ func benchmarkUpdaterWithNBots(b *testing.B, numBot int) {
 	wg.Wait()
 	d.Stop()
 }
+
+func TestUpdaterStopWithContext(t *testing.T) {
+	var committedOffset atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/getUpdates") {
+			t.Errorf("unexpected API call to %s", r.URL.Path)
+			return
+		}
+
+		params := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+			t.Errorf("failed to decode request params: %v", err)
+		}
+
+		switch {
+		case params["timeout"] == "0":
+			// This is the offset commit.
+			committedOffset.Store(params["offset"])
+			fmt.Fprint(w, `{"ok": true, "result": []}`)
+		case params["offset"] == "":
+			fmt.Fprint(w, `{"ok": true, "result": [
+				{"update_id": 5, "message": {"text": "slow"}},
+				{"update_id": 6, "message": {"text": "fast"}}
+			]}`)
+		default:
+			// Long poll until the request is cancelled.
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second * 10):
+			}
+			fmt.Fprint(w, `{"ok": true, "result": []}`)
+		}
+	}))
+	defer server.Close()
+
+	reqOpts := &gotgbot.RequestOpts{
+		APIURL:  server.URL,
+		Timeout: time.Second * 20,
+	}
+
+	b := &gotgbot.Bot{
+		Token: "SOME_TOKEN",
+		BotClient: &gotgbot.BaseBotClient{
+			DefaultRequestOpts: reqOpts,
+		},
+	}
+
+	d := ext.NewDispatcher(nil)
+	u := ext.NewUpdater(d, nil)
+
+	processed := make(chan struct{}, 2)
+	handlerCancelled := make(chan struct{})
+	d.AddHandler(handlers.NewMessage(message.Text, func(b *gotgbot.Bot, ctx *ext.Context) error {
+		processed <- struct{}{}
+		if ctx.EffectiveMessage.Text == "slow" {
+			<-ctx.Done()
+			close(handlerCancelled)
+		}
+		return nil
+	}))
+
+	err := u.StartPolling(b, &ext.PollingOpts{
+		GetUpdatesOpts: &gotgbot.GetUpdatesOpts{
+			Timeout:     10,
+			RequestOpts: reqOpts,
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to start polling: %v", err)
+	}
+
+	// Wait for both updates to be received.
+	<-processed
+	<-processed
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*200)
+	defer cancel()
+
+	start := time.Now()
+	err = u.StopWithContext(ctx)
+	if time.Since(start) > time.Second*5 {
+		t.Errorf("expected the in-flight getUpdates call to be cancelled")
+	}
+
+	var abandonedErr *ext.AbandonedUpdatesError
+	if !errors.As(err, &abandonedErr) {
+		t.Fatalf("expected an AbandonedUpdatesError, got: %v", err)
+	}
+	if ids := abandonedErr.Updates[b.Id]; len(ids) != 1 || ids[0] != 5 {
+		t.Errorf("expected update 5 to be abandoned, got %v", abandonedErr.Updates)
+	}
+	if offset := committedOffset.Load(); offset != nil {
+		t.Errorf("expected no offset to be committed once the deadline has passed, got %v", offset)
+	}
+
+	select {
+	case <-handlerCancelled:
+	case <-time.After(time.Second):
+		t.Errorf("expected handler context to be cancelled")
+	}
+}
+
+func TestUpdaterOnlyCommitsOffsetsOnStopWithContext(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		stop func(u *ext.Updater) error
+		// offset is the expected committed offset; or nil, if no offset should be committed.
+		offset interface{}
+	}{
+		{
+			// Stop must not make any requests to telegram.
+			name: "Stop",
+			stop: func(u *ext.Updater) error {
+				return u.Stop()
+			},
+			offset: nil,
+		}, {
+			name: "StopWithContext",
+			stop: func(u *ext.Updater) error {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+				defer cancel()
+				return u.StopWithContext(ctx)
+			},
+			offset: "6",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var committedOffset atomic.Value
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				params := map[string]string{}
+				if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+					t.Errorf("failed to decode request params: %v", err)
+				}
+
+				switch {
+				case params["timeout"] == "0":
+					// This is the offset commit.
+					committedOffset.Store(params["offset"])
+					fmt.Fprint(w, `{"ok": true, "result": []}`)
+				case params["offset"] == "":
+					fmt.Fprint(w, `{"ok": true, "result": [{"update_id": 5, "message": {"text": "hello"}}]}`)
+				default:
+					// Long poll until the request is cancelled.
+					select {
+					case <-r.Context().Done():
+					case <-time.After(time.Second * 10):
+					}
+					fmt.Fprint(w, `{"ok": true, "result": []}`)
+				}
+			}))
+			defer server.Close()
+
+			reqOpts := &gotgbot.RequestOpts{
+				APIURL:  server.URL,
+				Timeout: time.Second * 20,
+			}
+
+			b := &gotgbot.Bot{
+				Token: "SOME_TOKEN",
+				BotClient: &gotgbot.BaseBotClient{
+					DefaultRequestOpts: reqOpts,
+				},
+			}
+
+			d := ext.NewDispatcher(nil)
+			u := ext.NewUpdater(d, nil)
+
+			processed := make(chan struct{}, 1)
+			d.AddHandler(handlers.NewMessage(message.Text, func(b *gotgbot.Bot, ctx *ext.Context) error {
+				processed <- struct{}{}
+				return nil
+			}))
+
+			err := u.StartPolling(b, &ext.PollingOpts{
+				GetUpdatesOpts: &gotgbot.GetUpdatesOpts{
+					Timeout:     10,
+					RequestOpts: reqOpts,
+				},
+			})
+			if err != nil {
+				t.Fatalf("failed to start polling: %v", err)
+			}
+			<-processed
+
+			if err := tc.stop(u); err != nil {
+				t.Fatalf("unexpected error when stopping: %v", err)
+			}
+
+			if offset := committedOffset.Load(); offset != tc.offset {
+				t.Errorf("expected committed offset %v, got %v", tc.offset, offset)
+			}
+		})
+	}
+}
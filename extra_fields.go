@@ -0,0 +1,55 @@
+package gotgbot
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+)
+
+// unmarshalExtraFields returns the fields of a JSON object which aren't in the set of known fields; or nil, if there
+// are none.
+func unmarshalExtraFields(b []byte, known map[string]bool) (map[string]json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+
+	for name := range fields {
+		if known[name] {
+			delete(fields, name)
+		}
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return fields, nil
+}
+
+// marshalExtraFields adds the extra fields to the end of a marshalled JSON object, sorted by name.
+func marshalExtraFields(b []byte, extras map[string]json.RawMessage) ([]byte, error) {
+	if len(extras) == 0 {
+		return b, nil
+	}
+
+	names := make([]string, 0, len(extras))
+	for name := range extras {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := bytes.NewBuffer(make([]byte, 0, len(b)+64*len(extras)))
+	out.Write(b[:len(b)-1])
+	for idx, name := range names {
+		if idx > 0 || len(b) > 2 {
+			out.WriteByte(',')
+		}
+
+		// Marshalling a string can't fail.
+		key, _ := json.Marshal(name)
+		out.Write(key)
+		out.WriteByte(':')
+		out.Write(extras[name])
+	}
+	out.WriteByte('}')
+	return out.Bytes(), nil
+}
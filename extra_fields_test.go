@@ -0,0 +1,96 @@
+package gotgbot
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestExtraFields(t *testing.T) {
+	// These helpers are only used by the generated code when the generator's -extra-fields mode is enabled, so they
+	// are tested directly here.
+	known := map[string]bool{"message_id": true, "text": true}
+	data := []byte(`{"message_id":1,"text":"hi","new_field":{"a":1},"another_field":[1,2]}`)
+
+	extras, err := unmarshalExtraFields(data, known)
+	if err != nil {
+		t.Fatalf("failed to unmarshal extra fields: %v", err)
+	}
+	if len(extras) != 2 || string(extras["new_field"]) != `{"a":1}` || string(extras["another_field"]) != "[1,2]" {
+		t.Errorf("expected only the unknown fields to be kept, got %s", extras)
+	}
+
+	out, err := marshalExtraFields([]byte(`{"message_id":1,"text":"hi"}`), extras)
+	if err != nil {
+		t.Fatalf("failed to marshal extra fields: %v", err)
+	}
+
+	var expected, actual interface{}
+	if err := json.Unmarshal(data, &expected); err != nil {
+		t.Fatalf("failed to unmarshal input: %v", err)
+	}
+	if err := json.Unmarshal(out, &actual); err != nil {
+		t.Fatalf("failed to unmarshal output %s: %v", out, err)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected marshalled object to keep all fields:\n%s\ngot:\n%s", data, out)
+	}
+
+	extras, err = unmarshalExtraFields([]byte(`{"message_id":1}`), known)
+	if err != nil || extras != nil {
+		t.Errorf("expected no extra fields, got %s (%v)", extras, err)
+	}
+
+	out, err = marshalExtraFields([]byte(`{}`), map[string]json.RawMessage{"new_field": json.RawMessage(`true`)})
+	if err != nil || string(out) != `{"new_field":true}` {
+		t.Errorf("expected extra fields to be added to an empty object, got %s (%v)", out, err)
+	}
+}
+
+func TestGeneratedExtraFields(t *testing.T) {
+	// The fixture types are generated with the generator's -extra-fields mode; see scripts/generate/types_test.go.
+	for name, tc := range map[string]struct {
+		data string
+		v    interface {
+			ExtraField(name string) (json.RawMessage, bool)
+		}
+	}{
+		"simple fields": {
+			data: `{"message_id":1,"text":"hi","new_field":{"a":1}}`,
+			v:    &ExtraFieldsFixture{},
+		},
+		"interface fields": {
+			data: `{"message_id":1,"origin":{"type":"hidden_user","date":2,"sender_user_name":"a"},"new_field":{"a":1}}`,
+			v:    &ExtraFieldsOriginFixture{},
+		},
+	} {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			if err := json.Unmarshal([]byte(tc.data), tc.v); err != nil {
+				t.Fatalf("failed to unmarshal: %v", err)
+			}
+			if f, ok := tc.v.ExtraField("new_field"); !ok || string(f) != `{"a":1}` {
+				t.Errorf("expected new_field to be kept, got %s (%v)", f, ok)
+			}
+			if f, ok := tc.v.ExtraField("message_id"); ok {
+				t.Errorf("expected known fields not to be kept as extra fields, got %s", f)
+			}
+
+			out, err := json.Marshal(tc.v)
+			if err != nil {
+				t.Fatalf("failed to marshal: %v", err)
+			}
+
+			var expected, actual interface{}
+			if err := json.Unmarshal([]byte(tc.data), &expected); err != nil {
+				t.Fatalf("failed to unmarshal input: %v", err)
+			}
+			if err := json.Unmarshal(out, &actual); err != nil {
+				t.Fatalf("failed to unmarshal output %s: %v", out, err)
+			}
+			if !reflect.DeepEqual(expected, actual) {
+				t.Errorf("expected marshalled object to keep all fields:\n%s\ngot:\n%s", tc.data, out)
+			}
+		})
+	}
+}
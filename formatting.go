@@ -8,37 +8,55 @@ import (
 	"unicode/utf16"
 )
 
-var mdMap = map[string]string{
-	"bold":   "*",
-	"italic": "_",
-	"code":   "`",
+var mdMap = map[MessageEntityType]string{
+	MessageEntityTypeBold:   "*",
+	MessageEntityTypeItalic: "_",
+	MessageEntityTypeCode:   "`",
+	MessageEntityTypePre:    "```",
 }
 
-var mdV2Map = map[string]string{
-	"bold":                  "*",
-	"italic":                "_",
-	"code":                  "`",
-	"pre":                   "```",
-	"underline":             "__",
-	"strikethrough":         "~",
-	"spoiler":               "||",
-	"blockquote":            ">",
-	"expandable_blockquote": "**>",
+var mdV2Map = map[MessageEntityType]string{
+	MessageEntityTypeBold:                 "*",
+	MessageEntityTypeItalic:               "_",
+	MessageEntityTypeCode:                 "`",
+	MessageEntityTypePre:                  "```",
+	MessageEntityTypeUnderline:            "__",
+	MessageEntityTypeStrikethrough:        "~",
+	MessageEntityTypeSpoiler:              "||",
+	MessageEntityTypeBlockquote:           ">",
+	MessageEntityTypeExpandableBlockquote: "**>",
 }
 
-var htmlMap = map[string]string{
-	"bold":                  "b",
-	"italic":                "i",
-	"code":                  "code",
-	"pre":                   "pre",
-	"underline":             "u",
-	"strikethrough":         "s",
-	"spoiler":               "span class=\"tg-spoiler\"",
-	"blockquote":            "blockquote",
-	"expandable_blockquote": "blockquote expandable",
+var htmlMap = map[MessageEntityType]string{
+	MessageEntityTypeBold:                 "b",
+	MessageEntityTypeItalic:               "i",
+	MessageEntityTypeCode:                 "code",
+	MessageEntityTypePre:                  "pre",
+	MessageEntityTypeUnderline:            "u",
+	MessageEntityTypeStrikethrough:        "s",
+	MessageEntityTypeSpoiler:              "span class=\"tg-spoiler\"",
+	MessageEntityTypeBlockquote:           "blockquote",
+	MessageEntityTypeExpandableBlockquote: "blockquote expandable",
 }
 
+// mdEscaper escapes all the characters which have a special meaning in legacy markdown text.
+var mdEscaper = strings.NewReplacer("_", "\\_", "*", "\\*", "`", "\\`", "[", "\\[")
+
+// mdV2Escaper escapes all the characters which have a special meaning in MarkdownV2 text.
+var mdV2Escaper = strings.NewReplacer(
+	"\\", "\\\\", "_", "\\_", "*", "\\*", "[", "\\[", "]", "\\]", "(", "\\(", ")", "\\)", "~", "\\~", "`", "\\`",
+	">", "\\>", "#", "\\#", "+", "\\+", "-", "\\-", "=", "\\=", "|", "\\|", "{", "\\{", "}", "\\}", ".", "\\.", "!", "\\!",
+)
+
+// mdV2CodeEscaper escapes the characters which have a special meaning inside MarkdownV2 code and pre entities.
+var mdV2CodeEscaper = strings.NewReplacer("\\", "\\\\", "`", "\\`")
+
+// mdV2URLEscaper escapes the characters which have a special meaning inside MarkdownV2 link URLs.
+var mdV2URLEscaper = strings.NewReplacer("\\", "\\\\", ")", "\\)")
+
 // OriginalMD gets the original markdown formatting of a message text.
+// Legacy markdown doesn't support nested entities, nor most of the newer entity types, so those are written as plain
+// text; use OriginalMDV2 or OriginalHTML to keep all the formatting.
 func (m Message) OriginalMD() string {
 	return getOrigMsgMD(utf16.Encode([]rune(m.Text)), m.Entities)
 }
@@ -54,6 +72,8 @@ func (m Message) OriginalHTML() string {
 }
 
 // OriginalCaptionMD gets the original markdown formatting of a message caption.
+// Legacy markdown doesn't support nested entities, nor most of the newer entity types, so those are written as plain
+// text; use OriginalCaptionMDV2 or OriginalCaptionHTML to keep all the formatting.
 func (m Message) OriginalCaptionMD() string {
 	return getOrigMsgMD(utf16.Encode([]rune(m.Caption)), m.CaptionEntities)
 }
@@ -68,38 +88,48 @@ func (m Message) OriginalCaptionHTML() string {
 	return getOrigMsgHTML(utf16.Encode([]rune(m.Caption)), m.CaptionEntities)
 }
 
-// Does not support nesting. only look at upper entities.
+// Legacy markdown does not support nesting, nor most of the newer entity types; only the outermost entities it supports
+// are kept, and everything else is written as plain text. Since this drops formatting, the output is not expected to
+// round-trip, and there is no legacy markdown parser to check it against.
 func getOrigMsgMD(utf16Data []uint16, ents []MessageEntity) string {
+	supported := make([]MessageEntity, 0, len(ents))
+	for _, ent := range ents {
+		//exhaustive:ignore
+		switch ent.Type {
+		case MessageEntityTypeBold, MessageEntityTypeItalic, MessageEntityTypeCode, MessageEntityTypePre, MessageEntityTypeTextMention, MessageEntityTypeTextLink:
+			supported = append(supported, ent)
+		}
+	}
+
 	out := strings.Builder{}
 	prev := int64(0)
-	for _, ent := range getUpperEntities(ents) {
+	for _, ent := range getUpperEntities(supported) {
 		newPrev := ent.Offset + ent.Length
-		prevText := string(utf16.Decode(utf16Data[prev:ent.Offset]))
+		prevText := mdEscaper.Replace(string(utf16.Decode(utf16Data[prev:ent.Offset])))
 
 		text := utf16.Decode(utf16Data[ent.Offset:newPrev])
 		pre, cleanCntnt, post := splitEdgeWhitespace(string(text), ent)
 		cleanCntntRune := []rune(cleanCntnt)
 
+		//exhaustive:ignore
 		switch ent.Type {
-		case "bold", "italic", "code":
+		case MessageEntityTypeBold, MessageEntityTypeItalic, MessageEntityTypeCode:
 			out.WriteString(prevText + pre + mdMap[ent.Type] + escapeContainedMDV1(cleanCntntRune, []rune(mdMap[ent.Type])) + mdMap[ent.Type] + post)
-		case "pre":
+		case MessageEntityTypePre:
 			if ent.Language == "" {
 				out.WriteString(prevText + pre + mdMap[ent.Type] + escapeContainedMDV1(cleanCntntRune, []rune(mdMap[ent.Type])) + mdMap[ent.Type] + post)
 			} else {
 				out.WriteString(prevText + pre + mdMap[ent.Type] + ent.Language + "\n" + escapeContainedMDV1(cleanCntntRune, []rune(mdMap[ent.Type])) + mdMap[ent.Type] + post)
 			}
-		case "text_mention":
+		case MessageEntityTypeTextMention:
 			out.WriteString(prevText + pre + "[" + escapeContainedMDV1(cleanCntntRune, []rune("[]()")) + "](tg://user?id=" + strconv.FormatInt(ent.User.Id, 10) + ")" + post)
-		case "text_link":
+		case MessageEntityTypeTextLink:
 			out.WriteString(prevText + pre + "[" + escapeContainedMDV1(cleanCntntRune, []rune("[]()")) + "](" + ent.Url + ")" + post)
-		default:
-			continue
 		}
 		prev = newPrev
 	}
 
-	out.WriteString(string(utf16.Decode(utf16Data[prev:])))
+	out.WriteString(mdEscaper.Replace(string(utf16.Decode(utf16Data[prev:]))))
 	return out.String()
 }
 
@@ -122,18 +152,18 @@ func getOrigMsgHTML(utf16Data []uint16, ents []MessageEntity) string {
 
 func getOrigMsgMDV2(utf16Data []uint16, ents []MessageEntity) string {
 	if len(ents) == 0 {
-		return string(utf16.Decode(utf16Data))
+		return mdV2Escaper.Replace(string(utf16.Decode(utf16Data)))
 	}
 
 	bd := strings.Builder{}
 	prev := int64(0)
 	for _, e := range getUpperEntities(ents) {
 		data, end := fillNestedMarkdownV2(utf16Data, e, prev, getChildEntities(e, ents))
-		bd.WriteString(data)
+		writeMarkdownV2(&bd, data)
 		prev = end
 	}
 
-	bd.WriteString(string(utf16.Decode(utf16Data[prev:])))
+	writeMarkdownV2(&bd, mdV2Escaper.Replace(string(utf16.Decode(utf16Data[prev:]))))
 	return bd.String()
 }
 
@@ -168,7 +198,7 @@ func fillNestedMarkdownV2(data []uint16, ent MessageEntity, start int64, entitie
 	entEnd := ent.Offset + ent.Length
 	if len(entities) == 0 || entEnd < entities[0].Offset {
 		// no nesting; just return straight away and move to next.
-		return writeFinalMarkdownV2(data, ent, start, string(utf16.Decode(data[ent.Offset:entEnd]))), entEnd
+		return writeFinalMarkdownV2(data, ent, start, escapeMDV2Content(ent, string(utf16.Decode(data[ent.Offset:entEnd])))), entEnd
 	}
 	subPrev := ent.Offset
 	subEnd := ent.Offset
@@ -182,36 +212,37 @@ func fillNestedMarkdownV2(data []uint16, ent MessageEntity, start int64, entitie
 		}
 
 		out, end := fillNestedMarkdownV2(data, e, subPrev, getChildEntities(e, entities))
-		bd.WriteString(out)
+		writeMarkdownV2(&bd, out)
 		subPrev = end
 	}
 
-	bd.WriteString(string(utf16.Decode(data[subPrev:entEnd])))
+	writeMarkdownV2(&bd, escapeMDV2Content(ent, string(utf16.Decode(data[subPrev:entEnd]))))
 
 	return writeFinalMarkdownV2(data, ent, start, bd.String()), entEnd
 }
 
 func writeFinalHTML(data []uint16, ent MessageEntity, start int64, cntnt string) string {
 	prevText := html.EscapeString(string(utf16.Decode(data[start:ent.Offset])))
+	//exhaustive:ignore
 	switch ent.Type {
-	case "bold", "italic", "code", "underline", "strikethrough", "spoiler":
+	case MessageEntityTypeBold, MessageEntityTypeItalic, MessageEntityTypeCode, MessageEntityTypeUnderline, MessageEntityTypeStrikethrough, MessageEntityTypeSpoiler:
 		return prevText + "<" + htmlMap[ent.Type] + ">" + cntnt + "</" + closeHTMLTag(htmlMap[ent.Type]) + ">"
-	case "pre":
+	case MessageEntityTypePre:
 		// <pre>text</pre>
 		if ent.Language == "" {
 			return prevText + "<pre>" + cntnt + "</pre>"
 		}
 		// <pre><code class="lang">text</code></pre>
-		return prevText + `<pre><code class="` + ent.Language + `">` + cntnt + "</code></pre>"
-	case "custom_emoji":
+		return prevText + `<pre><code class="language-` + html.EscapeString(ent.Language) + `">` + cntnt + "</code></pre>"
+	case MessageEntityTypeCustomEmoji:
 		return prevText + `<tg-emoji emoji-id="` + ent.CustomEmojiId + `">` + cntnt + "</tg-emoji>"
-	case "text_mention":
+	case MessageEntityTypeTextMention:
 		return prevText + `<a href="tg://user?id=` + strconv.FormatInt(ent.User.Id, 10) + `">` + cntnt + "</a>"
-	case "text_link":
-		return prevText + `<a href="` + ent.Url + `">` + cntnt + "</a>"
-	case "blockquote":
+	case MessageEntityTypeTextLink:
+		return prevText + `<a href="` + html.EscapeString(ent.Url) + `">` + cntnt + "</a>"
+	case MessageEntityTypeBlockquote:
 		return prevText + `<blockquote>` + cntnt + "</blockquote>"
-	case "expandable_blockquote":
+	case MessageEntityTypeExpandableBlockquote:
 		return prevText + `<blockquote expandable>` + cntnt + "</blockquote>"
 	default:
 		return prevText + cntnt
@@ -226,32 +257,71 @@ func closeHTMLTag(s string) string {
 	return "span"
 }
 
+// writeFinalMarkdownV2 writes the markdownV2 for an entity, and the plain text preceding it. Unlike legacy markdown,
+// markdownV2 delimiters don't need to be next to non-whitespace characters, so any whitespace at the edges of the
+// entity is kept inside it.
 func writeFinalMarkdownV2(data []uint16, ent MessageEntity, start int64, cntnt string) string {
-	prevText := string(utf16.Decode(data[start:ent.Offset]))
-	pre, cleanCntnt, post := splitEdgeWhitespace(cntnt, ent)
+	prevText := mdV2Escaper.Replace(string(utf16.Decode(data[start:ent.Offset])))
+
+	var parts []string
+	//exhaustive:ignore
 	switch ent.Type {
-	case "bold", "italic", "code", "underline", "strikethrough", "spoiler":
-		return prevText + pre + mdV2Map[ent.Type] + cleanCntnt + mdV2Map[ent.Type] + post
-	case "pre":
-		if ent.Language == "" {
-			return prevText + pre + "```\n" + cleanCntnt + "```" + post
+	case MessageEntityTypeBold, MessageEntityTypeItalic, MessageEntityTypeCode, MessageEntityTypeUnderline, MessageEntityTypeStrikethrough:
+		parts = []string{prevText, mdV2Map[ent.Type], cntnt, mdV2Map[ent.Type]}
+	case MessageEntityTypeSpoiler:
+		// "||" at the end of a line ends expandable blockquotes, so spoilers starting with a line break are opened
+		// with a "\r" in between.
+		if strings.HasPrefix(cntnt, "\n") {
+			parts = []string{prevText, mdV2Map[ent.Type] + "\r", cntnt, mdV2Map[ent.Type]}
+		} else {
+			parts = []string{prevText, mdV2Map[ent.Type], cntnt, mdV2Map[ent.Type]}
 		}
-		return prevText + pre + "```" + ent.Language + "\n" + cleanCntnt + "```" + post
-	case "custom_emoji":
+	case MessageEntityTypePre:
+		parts = []string{prevText, "```" + ent.Language + "\n", cntnt, "```"}
+	case MessageEntityTypeCustomEmoji:
 		// Yes, custom emoji have a weird little ! at the front
 		// https://core.telegram.org/bots/api#markdownv2-style
-		return prevText + pre + "![" + cleanCntnt + "](tg://emoji?id=" + ent.CustomEmojiId + ")" + post
-	case "text_mention":
-		return prevText + pre + "[" + cleanCntnt + "](tg://user?id=" + strconv.FormatInt(ent.User.Id, 10) + ")" + post
-	case "text_link":
-		return prevText + pre + "[" + cleanCntnt + "](" + ent.Url + ")" + post
-	case "blockquote":
-		return prevText + pre + ">" + strings.Join(strings.Split(cleanCntnt, "\n"), "\n>") + post
-	case "expandable_blockquote":
-		return prevText + pre + "**>" + strings.Join(strings.Split(cleanCntnt, "\n"), "\n>") + "||" + post
+		parts = []string{prevText, "![", cntnt, "](tg://emoji?id=" + ent.CustomEmojiId + ")"}
+	case MessageEntityTypeTextMention:
+		parts = []string{prevText, "[", cntnt, "](tg://user?id=" + strconv.FormatInt(ent.User.Id, 10) + ")"}
+	case MessageEntityTypeTextLink:
+		parts = []string{prevText, "[", cntnt, "](" + mdV2URLEscaper.Replace(ent.Url) + ")"}
+	case MessageEntityTypeBlockquote:
+		// A blockquote ends at the end of its last line, so any trailing line breaks have to be written after it;
+		// otherwise, the following line would be quoted too.
+		quoted := strings.TrimRight(cntnt, "\n")
+		parts = []string{prevText, ">", strings.Join(strings.Split(quoted, "\n"), "\n>"), cntnt[len(quoted):]}
+	case MessageEntityTypeExpandableBlockquote:
+		parts = []string{prevText, "**>", strings.Join(strings.Split(cntnt, "\n"), "\n>"), "||"}
 	default:
-		return prevText + cntnt
+		parts = []string{prevText, cntnt}
+	}
+
+	bd := strings.Builder{}
+	for _, p := range parts {
+		writeMarkdownV2(&bd, p)
+	}
+	return bd.String()
+}
+
+// writeMarkdownV2 appends markdownV2 text to the builder. Consecutive underscores are always parsed as an underline
+// delimiter, so italic and underline delimiters which follow each other are separated by a "\r", which telegram ignores.
+func writeMarkdownV2(bd *strings.Builder, s string) {
+	if s == "" {
+		return
+	}
+	if s[0] == '_' && strings.HasSuffix(bd.String(), "_") {
+		bd.WriteByte('\r')
 	}
+	bd.WriteString(s)
+}
+
+// escapeMDV2Content escapes the text contained in an entity; code and pre entities use different escaping rules.
+func escapeMDV2Content(ent MessageEntity, text string) string {
+	if ent.Type == MessageEntityTypeCode || ent.Type == MessageEntityTypePre {
+		return mdV2CodeEscaper.Replace(text)
+	}
+	return mdV2Escaper.Replace(text)
 }
 
 func getUpperEntities(ents []MessageEntity) []MessageEntity {
@@ -282,23 +352,21 @@ func getChildEntities(ent MessageEntity, ents []MessageEntity) []MessageEntity {
 	return children
 }
 
+// splitEdgeWhitespace splits the whitespace at the edges of an entity's text from its content. Leading line breaks are
+// kept in the content of pre entities, since they are part of the code block.
 func splitEdgeWhitespace(text string, ent MessageEntity) (pre string, cntnt string, post string) {
-	keepNewLines := ent.Type == "pre"
+	keepNewLines := ent.Type == MessageEntityTypePre
 
-	bd := strings.Builder{}
 	rText := []rune(text)
-	for i := 0; i < len(rText) && unicode.IsSpace(rText[i]) && (!keepNewLines || rText[i] != '\n'); i++ {
-		bd.WriteRune(rText[i])
+	start := 0
+	for start < len(rText) && unicode.IsSpace(rText[start]) && (!keepNewLines || rText[start] != '\n') {
+		start++
 	}
-	pre = bd.String()
-
-	text = strings.TrimPrefix(text, pre)
-	bd.Reset()
-	for i := len(rText) - 1; i >= 0 && unicode.IsSpace(rText[i]); i-- {
-		bd.WriteRune(rText[i])
+	end := len(rText)
+	for end > start && unicode.IsSpace(rText[end-1]) {
+		end--
 	}
-	post = bd.String()
-	return pre, strings.TrimSuffix(text, post), post
+	return string(rText[:start]), string(rText[start:end]), string(rText[end:])
 }
 
 func escapeContainedMDV1(data []rune, mdType []rune) string {
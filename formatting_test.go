@@ -0,0 +1,374 @@
+package gotgbot_test
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+)
+
+func TestOriginalMD(t *testing.T) {
+	for name, tc := range map[string]struct {
+		text     string
+		entities []gotgbot.MessageEntity
+		expected string
+	}{
+		"escaped plain text": {
+			text:     "snake_case *stars* [link]",
+			expected: `snake\_case \*stars\* \[link]`,
+		},
+		"simple entities": {
+			text: "bold italic code",
+			entities: []gotgbot.MessageEntity{
+				{Type: "bold", Offset: 0, Length: 4},
+				{Type: "italic", Offset: 5, Length: 6},
+				{Type: "code", Offset: 12, Length: 4},
+			},
+			expected: "*bold* _italic_ `code`",
+		},
+		"pre": {
+			text: "x := 1",
+			entities: []gotgbot.MessageEntity{
+				{Type: "pre", Offset: 0, Length: 6, Language: "go"},
+			},
+			expected: "```go\nx := 1```",
+		},
+		"links": {
+			text: "link user",
+			entities: []gotgbot.MessageEntity{
+				{Type: "text_link", Offset: 0, Length: 4, Url: "https://example.com"},
+				{Type: "text_mention", Offset: 5, Length: 4, User: &gotgbot.User{Id: 123}},
+			},
+			expected: "[link](https://example.com) [user](tg://user?id=123)",
+		},
+		"unsupported entities keep supported children": {
+			text: "under bold",
+			entities: []gotgbot.MessageEntity{
+				{Type: "underline", Offset: 0, Length: 10},
+				{Type: "bold", Offset: 6, Length: 4},
+			},
+			expected: "under *bold*",
+		},
+		"nested entities are dropped": {
+			text: "bold italic",
+			entities: []gotgbot.MessageEntity{
+				{Type: "bold", Offset: 0, Length: 11},
+				{Type: "italic", Offset: 5, Length: 6},
+			},
+			expected: "*bold italic*",
+		},
+	} {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			m := gotgbot.Message{Text: tc.text, Entities: tc.entities}
+			if out := m.OriginalMD(); out != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, out)
+			}
+		})
+	}
+}
+
+func TestOriginalMDV2(t *testing.T) {
+	for name, tc := range map[string]struct {
+		text     string
+		entities []gotgbot.MessageEntity
+		expected string
+	}{
+		"italic underline": {
+			text: "both",
+			entities: []gotgbot.MessageEntity{
+				{Type: "italic", Offset: 0, Length: 4},
+				{Type: "underline", Offset: 0, Length: 4},
+			},
+			expected: "_\r__both__\r_",
+		},
+		"adjacent italics": {
+			text: "ab",
+			entities: []gotgbot.MessageEntity{
+				{Type: "italic", Offset: 0, Length: 1},
+				{Type: "italic", Offset: 1, Length: 1},
+			},
+			expected: "_a_\r_b_",
+		},
+		"quoted code block": {
+			text: "quote\na\nb",
+			entities: []gotgbot.MessageEntity{
+				{Type: "blockquote", Offset: 0, Length: 9},
+				{Type: "pre", Offset: 6, Length: 3},
+			},
+			expected: ">quote\n>```\n>a\n>b```",
+		},
+		"whitespace code": {
+			text: "\n",
+			entities: []gotgbot.MessageEntity{
+				{Type: "code", Offset: 0, Length: 1},
+			},
+			expected: "`\n`",
+		},
+		"whitespace pre": {
+			text: " ",
+			entities: []gotgbot.MessageEntity{
+				{Type: "pre", Offset: 0, Length: 1},
+			},
+			expected: "```\n ```",
+		},
+		"bold ending with a line break": {
+			text: "`\n",
+			entities: []gotgbot.MessageEntity{
+				{Type: "bold", Offset: 0, Length: 2},
+			},
+			expected: "*\\`\n*",
+		},
+		"quoted spoiler starting with a line break": {
+			text: "a\nb",
+			entities: []gotgbot.MessageEntity{
+				{Type: "expandable_blockquote", Offset: 0, Length: 3},
+				{Type: "spoiler", Offset: 1, Length: 2},
+			},
+			expected: "**>a||\r\n>b||||",
+		},
+	} {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			m := gotgbot.Message{Text: tc.text, Entities: tc.entities}
+			out := m.OriginalMDV2()
+			if out != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, out)
+			}
+
+			text, ents, err := gotgbot.ParseMarkdownV2(out)
+			checkParseResult(t, text, ents, err, tc.text, tc.entities, "")
+		})
+	}
+}
+
+// formattedText is a random text with properly nested formatting entities, as telegram would send them.
+// Entities which telegram detects automatically (such as mentions and URLs) are not generated, since they have no
+// formatting of their own.
+type formattedText struct {
+	Text     string
+	Entities []gotgbot.MessageEntity
+}
+
+// formattedTextChars are the characters used in formatted texts; this includes characters which need to be escaped.
+var formattedTextChars = []rune("ab_*[]()~`>#+-=|{}.!\\<>&\"'é😀")
+
+func (formattedText) Generate(r *rand.Rand, size int) reflect.Value {
+	tb := gotgbot.NewTextBuilder()
+
+	lines := 1 + r.Intn(4)
+	quoted := false
+	for i := 0; i < lines; i++ {
+		if i > 0 {
+			tb.Text("\n")
+		}
+
+		// Adjacent blockquotes would be merged when parsed, so quoted lines are always separated by plain lines.
+		if !quoted && r.Intn(3) == 0 {
+			quoted = true
+			quote := gotgbot.MessageEntityTypeBlockquote
+			if r.Intn(2) == 0 {
+				quote = gotgbot.MessageEntityTypeExpandableBlockquote
+			}
+
+			tb.Start(gotgbot.MessageEntity{Type: quote})
+			for j := 1 + r.Intn(2); j > 0; j-- {
+				generateInline(r, tb, 3, map[gotgbot.MessageEntityType]bool{})
+				if j > 1 {
+					tb.Text("\n")
+				}
+			}
+			tb.End()
+			continue
+		}
+
+		quoted = false
+		generateInline(r, tb, 3, map[gotgbot.MessageEntityType]bool{})
+	}
+
+	return reflect.ValueOf(formattedText{Text: tb.String(), Entities: tb.Entities()})
+}
+
+// generateInline adds random text and entities. Entities are only nested the way telegram allows it, and may start or
+// end with whitespace.
+func generateInline(r *rand.Rand, tb *gotgbot.TextBuilder, depth int, parents map[gotgbot.MessageEntityType]bool) {
+	for i := 1 + r.Intn(3); i > 0; i-- {
+		if depth == 0 || r.Intn(2) == 0 {
+			tb.Text(generateWord(r))
+		} else {
+			generateEntity(r, tb, depth, parents)
+		}
+		if i > 1 && r.Intn(2) == 0 {
+			tb.Text(" ")
+		}
+	}
+}
+
+func generateEntity(r *rand.Rand, tb *gotgbot.TextBuilder, depth int, parents map[gotgbot.MessageEntityType]bool) {
+	types := []gotgbot.MessageEntityType{"bold", "italic", "underline", "strikethrough", "spoiler", "code", "pre", "text_link", "text_mention", "custom_emoji"}
+	entType := types[r.Intn(len(types))]
+	if parents[entType] || (entType == "text_mention" && parents["text_link"]) || (entType == "text_link" && parents["text_mention"]) {
+		tb.Text(generateWord(r))
+		return
+	}
+
+	switch entType {
+	case "code":
+		tb.Code(generateCode(r))
+	case "pre":
+		lang := ""
+		if r.Intn(2) == 0 {
+			lang = "go"
+		}
+		tb.Pre(generateCode(r)+"\n"+generateCode(r), lang)
+	case "custom_emoji":
+		tb.CustomEmoji("😀", "5368324170671202286")
+	default:
+		ent := gotgbot.MessageEntity{Type: entType}
+		switch entType {
+		case "text_link":
+			ent.Url = "https://example.com/" + generateWord(r)
+		case "text_mention":
+			ent.User = &gotgbot.User{Id: r.Int63()}
+		}
+
+		parents[entType] = true
+		tb.Start(ent)
+		tb.Text(generateSpace(r))
+		generateInline(r, tb, depth-1, parents)
+		tb.Text(generateSpace(r))
+		tb.End()
+		parents[entType] = false
+	}
+}
+
+func generateWord(r *rand.Rand) string {
+	bd := strings.Builder{}
+	for i := 1 + r.Intn(6); i > 0; i-- {
+		bd.WriteRune(formattedTextChars[r.Intn(len(formattedTextChars))])
+	}
+	return bd.String()
+}
+
+// generateSpace returns either no whitespace, a space, or a line break.
+func generateSpace(r *rand.Rand) string {
+	return []string{"", "", " ", "\n"}[r.Intn(4)]
+}
+
+// generateCode returns the text of a code entity, which may be surrounded by whitespace, or consist only of whitespace.
+func generateCode(r *rand.Rand) string {
+	if r.Intn(4) == 0 {
+		return []string{" ", "\n", " \n "}[r.Intn(3)]
+	}
+	return generateSpace(r) + generateWord(r) + generateSpace(r)
+}
+
+// TestFormattingRoundTrip checks that formatted texts are parsed back into the original message. Legacy markdown is
+// not covered, since it can't represent nested entities, nor most entity types.
+func TestFormattingRoundTrip(t *testing.T) {
+	for name, tc := range map[string]struct {
+		format func(m gotgbot.Message) string
+		parse  func(string) (string, []gotgbot.MessageEntity, error)
+	}{
+		"html": {
+			format: gotgbot.Message.OriginalHTML,
+			parse:  gotgbot.ParseHTML,
+		},
+		"markdownv2": {
+			format: gotgbot.Message.OriginalMDV2,
+			parse:  gotgbot.ParseMarkdownV2,
+		},
+	} {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			roundTrip := func(f formattedText) bool {
+				m := gotgbot.Message{Text: f.Text, Entities: f.Entities}
+				formatted := tc.format(m)
+
+				text, ents, err := tc.parse(formatted)
+				if err != nil {
+					t.Logf("failed to parse %q: %v", formatted, err)
+					return false
+				}
+				if len(ents) == 0 && len(m.Entities) == 0 {
+					return text == m.Text
+				}
+				if text != m.Text || !reflect.DeepEqual(ents, m.Entities) {
+					t.Logf("formatted text %q parsed as:\n%q %+v\nexpected:\n%q %+v", formatted, text, ents, m.Text, m.Entities)
+					return false
+				}
+				return true
+			}
+
+			err := quick.Check(roundTrip, &quick.Config{
+				MaxCount: 2000,
+				Rand:     rand.New(rand.NewSource(1)), // nolint:gosec // Deterministic test data.
+			})
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// TestOriginalFormattingEscaping checks that reserved characters are escaped when rebuilding formatted text, such
+// that the output can be sent back to telegram as-is.
+func TestOriginalFormattingEscaping(t *testing.T) {
+	for name, tc := range map[string]struct {
+		format   func(gotgbot.Message) string
+		msg      gotgbot.Message
+		expected string
+	}{
+		"markdownv2 plain text": {
+			format:   gotgbot.Message.OriginalMDV2,
+			msg:      gotgbot.Message{Text: "1.5 * 2 = 3!"},
+			expected: `1\.5 \* 2 \= 3\!`,
+		},
+		"markdownv2 text around entities": {
+			format: gotgbot.Message.OriginalMDV2,
+			msg: gotgbot.Message{Text: "a.b snake_case (c)", Entities: []gotgbot.MessageEntity{
+				{Type: "bold", Offset: 4, Length: 10},
+			}},
+			expected: `a\.b *snake\_case* \(c\)`,
+		},
+		"markdownv2 code": {
+			format: gotgbot.Message.OriginalMDV2,
+			msg: gotgbot.Message{Text: "a`b\\c.", Entities: []gotgbot.MessageEntity{
+				{Type: "code", Offset: 0, Length: 6},
+			}},
+			expected: "`a\\`b\\\\c.`",
+		},
+		"markdownv2 link url": {
+			format: gotgbot.Message.OriginalMDV2,
+			msg: gotgbot.Message{Text: "link", Entities: []gotgbot.MessageEntity{
+				{Type: "text_link", Offset: 0, Length: 4, Url: "https://example.com/(a)"},
+			}},
+			expected: `[link](https://example.com/(a\))`,
+		},
+		"html pre language": {
+			format: gotgbot.Message.OriginalHTML,
+			msg: gotgbot.Message{Text: "x := 1", Entities: []gotgbot.MessageEntity{
+				{Type: "pre", Offset: 0, Length: 6, Language: "go"},
+			}},
+			expected: `<pre><code class="language-go">x := 1</code></pre>`,
+		},
+		"html link url": {
+			format: gotgbot.Message.OriginalHTML,
+			msg: gotgbot.Message{Text: "link", Entities: []gotgbot.MessageEntity{
+				{Type: "text_link", Offset: 0, Length: 4, Url: `https://example.com/?a=1&b="2"`},
+			}},
+			expected: `<a href="https://example.com/?a=1&amp;b=&#34;2&#34;">link</a>`,
+		},
+	} {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got := tc.format(tc.msg)
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
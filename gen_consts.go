@@ -115,32 +115,571 @@ const (
 	ParseModeNone       = ""
 )
 
-// The consts listed below represent all the chat action options that can be sent to telegram.
+// BackgroundFillType represents the values of the BackgroundFill.type field.
+type BackgroundFillType string
+
+// The consts listed below represent all the BackgroundFillType values known to this version of the library.
+const (
+	BackgroundFillTypeSolid            BackgroundFillType = "solid"
+	BackgroundFillTypeGradient         BackgroundFillType = "gradient"
+	BackgroundFillTypeFreeformGradient BackgroundFillType = "freeform_gradient"
+)
+
+// IsKnown returns true if the BackgroundFillType is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v BackgroundFillType) IsKnown() bool {
+	switch v {
+	case BackgroundFillTypeSolid, BackgroundFillTypeGradient, BackgroundFillTypeFreeformGradient:
+		return true
+	default:
+		return false
+	}
+}
+
+// BackgroundTypeType represents the values of the BackgroundType.type field.
+type BackgroundTypeType string
+
+// The consts listed below represent all the BackgroundTypeType values known to this version of the library.
+const (
+	BackgroundTypeTypeFill      BackgroundTypeType = "fill"
+	BackgroundTypeTypeWallpaper BackgroundTypeType = "wallpaper"
+	BackgroundTypeTypePattern   BackgroundTypeType = "pattern"
+	BackgroundTypeTypeChatTheme BackgroundTypeType = "chat_theme"
+)
+
+// IsKnown returns true if the BackgroundTypeType is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v BackgroundTypeType) IsKnown() bool {
+	switch v {
+	case BackgroundTypeTypeFill, BackgroundTypeTypeWallpaper, BackgroundTypeTypePattern, BackgroundTypeTypeChatTheme:
+		return true
+	default:
+		return false
+	}
+}
+
+// BotCommandScopeType represents the values of the BotCommandScope.type field.
+type BotCommandScopeType string
+
+// The consts listed below represent all the BotCommandScopeType values known to this version of the library.
+const (
+	BotCommandScopeTypeDefault               BotCommandScopeType = "default"
+	BotCommandScopeTypeAllPrivateChats       BotCommandScopeType = "all_private_chats"
+	BotCommandScopeTypeAllGroupChats         BotCommandScopeType = "all_group_chats"
+	BotCommandScopeTypeAllChatAdministrators BotCommandScopeType = "all_chat_administrators"
+	BotCommandScopeTypeChat                  BotCommandScopeType = "chat"
+	BotCommandScopeTypeChatAdministrators    BotCommandScopeType = "chat_administrators"
+	BotCommandScopeTypeChatMember            BotCommandScopeType = "chat_member"
+)
+
+// IsKnown returns true if the BotCommandScopeType is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v BotCommandScopeType) IsKnown() bool {
+	switch v {
+	case BotCommandScopeTypeDefault, BotCommandScopeTypeAllPrivateChats, BotCommandScopeTypeAllGroupChats, BotCommandScopeTypeAllChatAdministrators, BotCommandScopeTypeChat, BotCommandScopeTypeChatAdministrators, BotCommandScopeTypeChatMember:
+		return true
+	default:
+		return false
+	}
+}
+
+// ChatAction represents the values of the sendChatAction.action field.
+type ChatAction string
+
+// The consts listed below represent all the ChatAction values known to this version of the library.
+const (
+	ChatActionTyping          ChatAction = "typing"
+	ChatActionUploadPhoto     ChatAction = "upload_photo"
+	ChatActionRecordVideo     ChatAction = "record_video"
+	ChatActionUploadVideo     ChatAction = "upload_video"
+	ChatActionRecordVoice     ChatAction = "record_voice"
+	ChatActionUploadVoice     ChatAction = "upload_voice"
+	ChatActionUploadDocument  ChatAction = "upload_document"
+	ChatActionChooseSticker   ChatAction = "choose_sticker"
+	ChatActionFindLocation    ChatAction = "find_location"
+	ChatActionRecordVideoNote ChatAction = "record_video_note"
+	ChatActionUploadVideoNote ChatAction = "upload_video_note"
+)
+
+// IsKnown returns true if the ChatAction is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v ChatAction) IsKnown() bool {
+	switch v {
+	case ChatActionTyping, ChatActionUploadPhoto, ChatActionRecordVideo, ChatActionUploadVideo, ChatActionRecordVoice, ChatActionUploadVoice, ChatActionUploadDocument, ChatActionChooseSticker, ChatActionFindLocation, ChatActionRecordVideoNote, ChatActionUploadVideoNote:
+		return true
+	default:
+		return false
+	}
+}
+
+// ChatBoostSourceSource represents the values of the ChatBoostSource.source field.
+type ChatBoostSourceSource string
+
+// The consts listed below represent all the ChatBoostSourceSource values known to this version of the library.
+const (
+	ChatBoostSourceSourcePremium  ChatBoostSourceSource = "premium"
+	ChatBoostSourceSourceGiftCode ChatBoostSourceSource = "gift_code"
+	ChatBoostSourceSourceGiveaway ChatBoostSourceSource = "giveaway"
+)
+
+// IsKnown returns true if the ChatBoostSourceSource is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v ChatBoostSourceSource) IsKnown() bool {
+	switch v {
+	case ChatBoostSourceSourcePremium, ChatBoostSourceSourceGiftCode, ChatBoostSourceSourceGiveaway:
+		return true
+	default:
+		return false
+	}
+}
+
+// ChatMemberStatus represents the values of the ChatMember.status field.
+type ChatMemberStatus string
+
+// The consts listed below represent all the ChatMemberStatus values known to this version of the library.
+const (
+	ChatMemberStatusCreator       ChatMemberStatus = "creator"
+	ChatMemberStatusAdministrator ChatMemberStatus = "administrator"
+	ChatMemberStatusMember        ChatMemberStatus = "member"
+	ChatMemberStatusRestricted    ChatMemberStatus = "restricted"
+	ChatMemberStatusLeft          ChatMemberStatus = "left"
+	ChatMemberStatusKicked        ChatMemberStatus = "kicked"
+)
+
+// IsKnown returns true if the ChatMemberStatus is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v ChatMemberStatus) IsKnown() bool {
+	switch v {
+	case ChatMemberStatusCreator, ChatMemberStatusAdministrator, ChatMemberStatusMember, ChatMemberStatusRestricted, ChatMemberStatusLeft, ChatMemberStatusKicked:
+		return true
+	default:
+		return false
+	}
+}
+
+// ChatType represents the values of the Chat.type field.
+type ChatType string
+
+// The consts listed below represent all the ChatType values known to this version of the library.
+const (
+	ChatTypePrivate    ChatType = "private"
+	ChatTypeGroup      ChatType = "group"
+	ChatTypeSupergroup ChatType = "supergroup"
+	ChatTypeChannel    ChatType = "channel"
+)
+
+// IsKnown returns true if the ChatType is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v ChatType) IsKnown() bool {
+	switch v {
+	case ChatTypePrivate, ChatTypeGroup, ChatTypeSupergroup, ChatTypeChannel:
+		return true
+	default:
+		return false
+	}
+}
+
+// EncryptedPassportElementType represents the values of the EncryptedPassportElement.type field.
+type EncryptedPassportElementType string
+
+// The consts listed below represent all the EncryptedPassportElementType values known to this version of the library.
+const (
+	EncryptedPassportElementTypePersonalDetails       EncryptedPassportElementType = "personal_details"
+	EncryptedPassportElementTypePassport              EncryptedPassportElementType = "passport"
+	EncryptedPassportElementTypeDriverLicense         EncryptedPassportElementType = "driver_license"
+	EncryptedPassportElementTypeIdentityCard          EncryptedPassportElementType = "identity_card"
+	EncryptedPassportElementTypeInternalPassport      EncryptedPassportElementType = "internal_passport"
+	EncryptedPassportElementTypeAddress               EncryptedPassportElementType = "address"
+	EncryptedPassportElementTypeUtilityBill           EncryptedPassportElementType = "utility_bill"
+	EncryptedPassportElementTypeBankStatement         EncryptedPassportElementType = "bank_statement"
+	EncryptedPassportElementTypeRentalAgreement       EncryptedPassportElementType = "rental_agreement"
+	EncryptedPassportElementTypePassportRegistration  EncryptedPassportElementType = "passport_registration"
+	EncryptedPassportElementTypeTemporaryRegistration EncryptedPassportElementType = "temporary_registration"
+	EncryptedPassportElementTypePhoneNumber           EncryptedPassportElementType = "phone_number"
+	EncryptedPassportElementTypeEmail                 EncryptedPassportElementType = "email"
+)
+
+// IsKnown returns true if the EncryptedPassportElementType is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v EncryptedPassportElementType) IsKnown() bool {
+	switch v {
+	case EncryptedPassportElementTypePersonalDetails, EncryptedPassportElementTypePassport, EncryptedPassportElementTypeDriverLicense, EncryptedPassportElementTypeIdentityCard, EncryptedPassportElementTypeInternalPassport, EncryptedPassportElementTypeAddress, EncryptedPassportElementTypeUtilityBill, EncryptedPassportElementTypeBankStatement, EncryptedPassportElementTypeRentalAgreement, EncryptedPassportElementTypePassportRegistration, EncryptedPassportElementTypeTemporaryRegistration, EncryptedPassportElementTypePhoneNumber, EncryptedPassportElementTypeEmail:
+		return true
+	default:
+		return false
+	}
+}
+
+// InlineQueryChatType represents the values of the InlineQuery.chat_type field.
+type InlineQueryChatType string
+
+// The consts listed below represent all the InlineQueryChatType values known to this version of the library.
+const (
+	InlineQueryChatTypeSender     InlineQueryChatType = "sender"
+	InlineQueryChatTypePrivate    InlineQueryChatType = "private"
+	InlineQueryChatTypeGroup      InlineQueryChatType = "group"
+	InlineQueryChatTypeSupergroup InlineQueryChatType = "supergroup"
+	InlineQueryChatTypeChannel    InlineQueryChatType = "channel"
+)
+
+// IsKnown returns true if the InlineQueryChatType is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v InlineQueryChatType) IsKnown() bool {
+	switch v {
+	case InlineQueryChatTypeSender, InlineQueryChatTypePrivate, InlineQueryChatTypeGroup, InlineQueryChatTypeSupergroup, InlineQueryChatTypeChannel:
+		return true
+	default:
+		return false
+	}
+}
+
+// InlineQueryResultType represents the values of the InlineQueryResult.type field.
+type InlineQueryResultType string
+
+// The consts listed below represent all the InlineQueryResultType values known to this version of the library.
 const (
-	ChatActionTyping          = "typing"
-	ChatActionUploadPhoto     = "upload_photo"
-	ChatActionRecordVideo     = "record_video"
-	ChatActionUploadVideo     = "upload_video"
-	ChatActionRecordVoice     = "record_voice"
-	ChatActionUploadVoice     = "upload_voice"
-	ChatActionUploadDocument  = "upload_document"
-	ChatActionChooseSticker   = "choose_sticker"
-	ChatActionFindLocation    = "find_location"
-	ChatActionRecordVideoNote = "record_video_note"
-	ChatActionUploadVideoNote = "upload_video_note"
+	InlineQueryResultTypeAudio    InlineQueryResultType = "audio"
+	InlineQueryResultTypeDocument InlineQueryResultType = "document"
+	InlineQueryResultTypeGif      InlineQueryResultType = "gif"
+	InlineQueryResultTypeMpeg4Gif InlineQueryResultType = "mpeg4_gif"
+	InlineQueryResultTypePhoto    InlineQueryResultType = "photo"
+	InlineQueryResultTypeSticker  InlineQueryResultType = "sticker"
+	InlineQueryResultTypeVideo    InlineQueryResultType = "video"
+	InlineQueryResultTypeVoice    InlineQueryResultType = "voice"
+	InlineQueryResultTypeArticle  InlineQueryResultType = "article"
+	InlineQueryResultTypeContact  InlineQueryResultType = "contact"
+	InlineQueryResultTypeGame     InlineQueryResultType = "game"
+	InlineQueryResultTypeLocation InlineQueryResultType = "location"
+	InlineQueryResultTypeVenue    InlineQueryResultType = "venue"
 )
 
-// The consts listed below represent all the sticker types that can be obtained from telegram.
+// IsKnown returns true if the InlineQueryResultType is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v InlineQueryResultType) IsKnown() bool {
+	switch v {
+	case InlineQueryResultTypeAudio, InlineQueryResultTypeDocument, InlineQueryResultTypeGif, InlineQueryResultTypeMpeg4Gif, InlineQueryResultTypePhoto, InlineQueryResultTypeSticker, InlineQueryResultTypeVideo, InlineQueryResultTypeVoice, InlineQueryResultTypeArticle, InlineQueryResultTypeContact, InlineQueryResultTypeGame, InlineQueryResultTypeLocation, InlineQueryResultTypeVenue:
+		return true
+	default:
+		return false
+	}
+}
+
+// InputMediaType represents the values of the InputMedia.type field.
+type InputMediaType string
+
+// The consts listed below represent all the InputMediaType values known to this version of the library.
 const (
-	StickerTypeRegular     = "regular"
-	StickerTypeMask        = "mask"
-	StickerTypeCustomEmoji = "custom_emoji"
+	InputMediaTypeAnimation InputMediaType = "animation"
+	InputMediaTypeDocument  InputMediaType = "document"
+	InputMediaTypeAudio     InputMediaType = "audio"
+	InputMediaTypePhoto     InputMediaType = "photo"
+	InputMediaTypeVideo     InputMediaType = "video"
 )
 
-// The consts listed below represent all the chat types that can be obtained from telegram.
+// IsKnown returns true if the InputMediaType is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v InputMediaType) IsKnown() bool {
+	switch v {
+	case InputMediaTypeAnimation, InputMediaTypeDocument, InputMediaTypeAudio, InputMediaTypePhoto, InputMediaTypeVideo:
+		return true
+	default:
+		return false
+	}
+}
+
+// InputPaidMediaType represents the values of the InputPaidMedia.type field.
+type InputPaidMediaType string
+
+// The consts listed below represent all the InputPaidMediaType values known to this version of the library.
 const (
-	ChatTypePrivate    = "private"
-	ChatTypeGroup      = "group"
-	ChatTypeSupergroup = "supergroup"
-	ChatTypeChannel    = "channel"
+	InputPaidMediaTypePhoto InputPaidMediaType = "photo"
+	InputPaidMediaTypeVideo InputPaidMediaType = "video"
 )
+
+// IsKnown returns true if the InputPaidMediaType is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v InputPaidMediaType) IsKnown() bool {
+	switch v {
+	case InputPaidMediaTypePhoto, InputPaidMediaTypeVideo:
+		return true
+	default:
+		return false
+	}
+}
+
+// InputStickerFormat represents the values of the InputSticker.format field.
+type InputStickerFormat string
+
+// The consts listed below represent all the InputStickerFormat values known to this version of the library.
+const (
+	InputStickerFormatStatic   InputStickerFormat = "static"
+	InputStickerFormatAnimated InputStickerFormat = "animated"
+	InputStickerFormatVideo    InputStickerFormat = "video"
+)
+
+// IsKnown returns true if the InputStickerFormat is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v InputStickerFormat) IsKnown() bool {
+	switch v {
+	case InputStickerFormatStatic, InputStickerFormatAnimated, InputStickerFormatVideo:
+		return true
+	default:
+		return false
+	}
+}
+
+// MaskPositionPoint represents the values of the MaskPosition.point field.
+type MaskPositionPoint string
+
+// The consts listed below represent all the MaskPositionPoint values known to this version of the library.
+const (
+	MaskPositionPointForehead MaskPositionPoint = "forehead"
+	MaskPositionPointEyes     MaskPositionPoint = "eyes"
+	MaskPositionPointMouth    MaskPositionPoint = "mouth"
+	MaskPositionPointChin     MaskPositionPoint = "chin"
+)
+
+// IsKnown returns true if the MaskPositionPoint is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v MaskPositionPoint) IsKnown() bool {
+	switch v {
+	case MaskPositionPointForehead, MaskPositionPointEyes, MaskPositionPointMouth, MaskPositionPointChin:
+		return true
+	default:
+		return false
+	}
+}
+
+// MenuButtonType represents the values of the MenuButton.type field.
+type MenuButtonType string
+
+// The consts listed below represent all the MenuButtonType values known to this version of the library.
+const (
+	MenuButtonTypeCommands MenuButtonType = "commands"
+	MenuButtonTypeWebApp   MenuButtonType = "web_app"
+	MenuButtonTypeDefault  MenuButtonType = "default"
+)
+
+// IsKnown returns true if the MenuButtonType is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v MenuButtonType) IsKnown() bool {
+	switch v {
+	case MenuButtonTypeCommands, MenuButtonTypeWebApp, MenuButtonTypeDefault:
+		return true
+	default:
+		return false
+	}
+}
+
+// MessageEntityType represents the values of the MessageEntity.type field.
+type MessageEntityType string
+
+// The consts listed below represent all the MessageEntityType values known to this version of the library.
+const (
+	MessageEntityTypeMention              MessageEntityType = "mention"
+	MessageEntityTypeHashtag              MessageEntityType = "hashtag"
+	MessageEntityTypeCashtag              MessageEntityType = "cashtag"
+	MessageEntityTypeBotCommand           MessageEntityType = "bot_command"
+	MessageEntityTypeUrl                  MessageEntityType = "url"
+	MessageEntityTypeEmail                MessageEntityType = "email"
+	MessageEntityTypePhoneNumber          MessageEntityType = "phone_number"
+	MessageEntityTypeBold                 MessageEntityType = "bold"
+	MessageEntityTypeItalic               MessageEntityType = "italic"
+	MessageEntityTypeUnderline            MessageEntityType = "underline"
+	MessageEntityTypeStrikethrough        MessageEntityType = "strikethrough"
+	MessageEntityTypeSpoiler              MessageEntityType = "spoiler"
+	MessageEntityTypeBlockquote           MessageEntityType = "blockquote"
+	MessageEntityTypeExpandableBlockquote MessageEntityType = "expandable_blockquote"
+	MessageEntityTypeCode                 MessageEntityType = "code"
+	MessageEntityTypePre                  MessageEntityType = "pre"
+	MessageEntityTypeTextLink             MessageEntityType = "text_link"
+	MessageEntityTypeTextMention          MessageEntityType = "text_mention"
+	MessageEntityTypeCustomEmoji          MessageEntityType = "custom_emoji"
+)
+
+// IsKnown returns true if the MessageEntityType is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v MessageEntityType) IsKnown() bool {
+	switch v {
+	case MessageEntityTypeMention, MessageEntityTypeHashtag, MessageEntityTypeCashtag, MessageEntityTypeBotCommand, MessageEntityTypeUrl, MessageEntityTypeEmail, MessageEntityTypePhoneNumber, MessageEntityTypeBold, MessageEntityTypeItalic, MessageEntityTypeUnderline, MessageEntityTypeStrikethrough, MessageEntityTypeSpoiler, MessageEntityTypeBlockquote, MessageEntityTypeExpandableBlockquote, MessageEntityTypeCode, MessageEntityTypePre, MessageEntityTypeTextLink, MessageEntityTypeTextMention, MessageEntityTypeCustomEmoji:
+		return true
+	default:
+		return false
+	}
+}
+
+// MessageOriginType represents the values of the MessageOrigin.type field.
+type MessageOriginType string
+
+// The consts listed below represent all the MessageOriginType values known to this version of the library.
+const (
+	MessageOriginTypeUser       MessageOriginType = "user"
+	MessageOriginTypeHiddenUser MessageOriginType = "hidden_user"
+	MessageOriginTypeChat       MessageOriginType = "chat"
+	MessageOriginTypeChannel    MessageOriginType = "channel"
+)
+
+// IsKnown returns true if the MessageOriginType is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v MessageOriginType) IsKnown() bool {
+	switch v {
+	case MessageOriginTypeUser, MessageOriginTypeHiddenUser, MessageOriginTypeChat, MessageOriginTypeChannel:
+		return true
+	default:
+		return false
+	}
+}
+
+// PaidMediaType represents the values of the PaidMedia.type field.
+type PaidMediaType string
+
+// The consts listed below represent all the PaidMediaType values known to this version of the library.
+const (
+	PaidMediaTypePreview PaidMediaType = "preview"
+	PaidMediaTypePhoto   PaidMediaType = "photo"
+	PaidMediaTypeVideo   PaidMediaType = "video"
+)
+
+// IsKnown returns true if the PaidMediaType is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v PaidMediaType) IsKnown() bool {
+	switch v {
+	case PaidMediaTypePreview, PaidMediaTypePhoto, PaidMediaTypeVideo:
+		return true
+	default:
+		return false
+	}
+}
+
+// PassportElementErrorSource represents the values of the PassportElementError.source field.
+type PassportElementErrorSource string
+
+// The consts listed below represent all the PassportElementErrorSource values known to this version of the library.
+const (
+	PassportElementErrorSourceData             PassportElementErrorSource = "data"
+	PassportElementErrorSourceFrontSide        PassportElementErrorSource = "front_side"
+	PassportElementErrorSourceReverseSide      PassportElementErrorSource = "reverse_side"
+	PassportElementErrorSourceSelfie           PassportElementErrorSource = "selfie"
+	PassportElementErrorSourceFile             PassportElementErrorSource = "file"
+	PassportElementErrorSourceFiles            PassportElementErrorSource = "files"
+	PassportElementErrorSourceTranslationFile  PassportElementErrorSource = "translation_file"
+	PassportElementErrorSourceTranslationFiles PassportElementErrorSource = "translation_files"
+	PassportElementErrorSourceUnspecified      PassportElementErrorSource = "unspecified"
+)
+
+// IsKnown returns true if the PassportElementErrorSource is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v PassportElementErrorSource) IsKnown() bool {
+	switch v {
+	case PassportElementErrorSourceData, PassportElementErrorSourceFrontSide, PassportElementErrorSourceReverseSide, PassportElementErrorSourceSelfie, PassportElementErrorSourceFile, PassportElementErrorSourceFiles, PassportElementErrorSourceTranslationFile, PassportElementErrorSourceTranslationFiles, PassportElementErrorSourceUnspecified:
+		return true
+	default:
+		return false
+	}
+}
+
+// PollType represents the values of the Poll.type field.
+type PollType string
+
+// The consts listed below represent all the PollType values known to this version of the library.
+const (
+	PollTypeRegular PollType = "regular"
+	PollTypeQuiz    PollType = "quiz"
+)
+
+// IsKnown returns true if the PollType is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v PollType) IsKnown() bool {
+	switch v {
+	case PollTypeRegular, PollTypeQuiz:
+		return true
+	default:
+		return false
+	}
+}
+
+// ReactionTypeType represents the values of the ReactionType.type field.
+type ReactionTypeType string
+
+// The consts listed below represent all the ReactionTypeType values known to this version of the library.
+const (
+	ReactionTypeTypeEmoji       ReactionTypeType = "emoji"
+	ReactionTypeTypeCustomEmoji ReactionTypeType = "custom_emoji"
+	ReactionTypeTypePaid        ReactionTypeType = "paid"
+)
+
+// IsKnown returns true if the ReactionTypeType is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v ReactionTypeType) IsKnown() bool {
+	switch v {
+	case ReactionTypeTypeEmoji, ReactionTypeTypeCustomEmoji, ReactionTypeTypePaid:
+		return true
+	default:
+		return false
+	}
+}
+
+// RevenueWithdrawalStateType represents the values of the RevenueWithdrawalState.type field.
+type RevenueWithdrawalStateType string
+
+// The consts listed below represent all the RevenueWithdrawalStateType values known to this version of the library.
+const (
+	RevenueWithdrawalStateTypePending   RevenueWithdrawalStateType = "pending"
+	RevenueWithdrawalStateTypeSucceeded RevenueWithdrawalStateType = "succeeded"
+	RevenueWithdrawalStateTypeFailed    RevenueWithdrawalStateType = "failed"
+)
+
+// IsKnown returns true if the RevenueWithdrawalStateType is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v RevenueWithdrawalStateType) IsKnown() bool {
+	switch v {
+	case RevenueWithdrawalStateTypePending, RevenueWithdrawalStateTypeSucceeded, RevenueWithdrawalStateTypeFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// StickerType represents the values of the Sticker.type field.
+type StickerType string
+
+// The consts listed below represent all the StickerType values known to this version of the library.
+const (
+	StickerTypeRegular     StickerType = "regular"
+	StickerTypeMask        StickerType = "mask"
+	StickerTypeCustomEmoji StickerType = "custom_emoji"
+)
+
+// IsKnown returns true if the StickerType is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v StickerType) IsKnown() bool {
+	switch v {
+	case StickerTypeRegular, StickerTypeMask, StickerTypeCustomEmoji:
+		return true
+	default:
+		return false
+	}
+}
+
+// TransactionPartnerType represents the values of the TransactionPartner.type field.
+type TransactionPartnerType string
+
+// The consts listed below represent all the TransactionPartnerType values known to this version of the library.
+const (
+	TransactionPartnerTypeUser        TransactionPartnerType = "user"
+	TransactionPartnerTypeFragment    TransactionPartnerType = "fragment"
+	TransactionPartnerTypeTelegramAds TransactionPartnerType = "telegram_ads"
+	TransactionPartnerTypeTelegramApi TransactionPartnerType = "telegram_api"
+	TransactionPartnerTypeOther       TransactionPartnerType = "other"
+)
+
+// IsKnown returns true if the TransactionPartnerType is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v TransactionPartnerType) IsKnown() bool {
+	switch v {
+	case TransactionPartnerTypeUser, TransactionPartnerTypeFragment, TransactionPartnerTypeTelegramAds, TransactionPartnerTypeTelegramApi, TransactionPartnerTypeOther:
+		return true
+	default:
+		return false
+	}
+}
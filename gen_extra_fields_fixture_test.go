@@ -0,0 +1,121 @@
+// THIS FILE IS AUTOGENERATED. DO NOT EDIT.
+// Regen by running 'go test ./scripts/generate -update' in the repo root.
+
+package gotgbot
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ExtraFieldsFixture (https://core.telegram.org/bots/api)
+//
+// This object is a test fixture for types which keep track of unknown JSON fields.
+type ExtraFieldsFixture struct {
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+	// Optional. Message text
+	Text string `json:"text,omitempty"`
+	// extras contains the fields sent by telegram which aren't known to this version of the library, by JSON name.
+	extras map[string]json.RawMessage
+}
+
+// extraFieldsFixtureJSONFields contains the JSON names of all the fields of ExtraFieldsFixture which are known to this version of the library.
+var extraFieldsFixtureJSONFields = map[string]bool{
+	"message_id": true,
+	"text":       true,
+}
+
+// UnmarshalJSON is a custom JSON unmarshaller to keep track of the fields which aren't known to this version of the library.
+func (v *ExtraFieldsFixture) UnmarshalJSON(b []byte) error {
+	type alias ExtraFieldsFixture
+	err := json.Unmarshal(b, (*alias)(v))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal ExtraFieldsFixture JSON: %w", err)
+	}
+
+	v.extras, err = unmarshalExtraFields(b, extraFieldsFixtureJSONFields)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal ExtraFieldsFixture extra fields: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON is a custom JSON marshaller to include the fields which aren't known to this version of the library.
+func (v ExtraFieldsFixture) MarshalJSON() ([]byte, error) {
+	type alias ExtraFieldsFixture
+	b, err := json.Marshal((alias)(v))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal ExtraFieldsFixture JSON: %w", err)
+	}
+	return marshalExtraFields(b, v.extras)
+}
+
+// ExtraField returns the raw JSON value of a field which isn't known to this version of the library, by its JSON name.
+// This allows for reading fields which telegram recently added, before the library is updated.
+func (v ExtraFieldsFixture) ExtraField(name string) (json.RawMessage, bool) {
+	f, ok := v.extras[name]
+	return f, ok
+}
+
+// ExtraFieldsOriginFixture (https://core.telegram.org/bots/api)
+//
+// This object is a test fixture for types with interface fields which keep track of unknown JSON fields.
+type ExtraFieldsOriginFixture struct {
+	// Message identifier
+	MessageId int64 `json:"message_id"`
+	// Origin of the message
+	Origin MessageOrigin `json:"origin"`
+	// extras contains the fields sent by telegram which aren't known to this version of the library, by JSON name.
+	extras map[string]json.RawMessage
+}
+
+// UnmarshalJSON is a custom JSON unmarshaller to use the helpers which allow for unmarshalling structs into interfaces.
+func (v *ExtraFieldsOriginFixture) UnmarshalJSON(b []byte) error {
+	// All fields in ExtraFieldsOriginFixture, with interface fields as json.RawMessage
+	type tmp struct {
+		MessageId int64           `json:"message_id"`
+		Origin    json.RawMessage `json:"origin"`
+	}
+	t := tmp{}
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal ExtraFieldsOriginFixture JSON into tmp struct: %w", err)
+	}
+
+	v.MessageId = t.MessageId
+	v.Origin, err = unmarshalMessageOrigin(t.Origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal custom JSON field Origin: %w", err)
+	}
+
+	v.extras, err = unmarshalExtraFields(b, extraFieldsOriginFixtureJSONFields)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal ExtraFieldsOriginFixture extra fields: %w", err)
+	}
+
+	return nil
+}
+
+// extraFieldsOriginFixtureJSONFields contains the JSON names of all the fields of ExtraFieldsOriginFixture which are known to this version of the library.
+var extraFieldsOriginFixtureJSONFields = map[string]bool{
+	"message_id": true,
+	"origin":     true,
+}
+
+// MarshalJSON is a custom JSON marshaller to include the fields which aren't known to this version of the library.
+func (v ExtraFieldsOriginFixture) MarshalJSON() ([]byte, error) {
+	type alias ExtraFieldsOriginFixture
+	b, err := json.Marshal((alias)(v))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal ExtraFieldsOriginFixture JSON: %w", err)
+	}
+	return marshalExtraFields(b, v.extras)
+}
+
+// ExtraField returns the raw JSON value of a field which isn't known to this version of the library, by its JSON name.
+// This allows for reading fields which telegram recently added, before the library is updated.
+func (v ExtraFieldsOriginFixture) ExtraField(name string) (json.RawMessage, bool) {
+	f, ok := v.extras[name]
+	return f, ok
+}
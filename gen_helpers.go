@@ -132,7 +132,7 @@ func (c Chat) RevokeInviteLink(b *Bot, inviteLink string, opts *RevokeChatInvite
 }
 
 // SendAction Helper method for Bot.SendChatAction.
-func (c Chat) SendAction(b *Bot, action string, opts *SendChatActionOpts) (bool, error) {
+func (c Chat) SendAction(b *Bot, action ChatAction, opts *SendChatActionOpts) (bool, error) {
 	return b.SendChatAction(c.Id, action, opts)
 }
 
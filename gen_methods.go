@@ -932,7 +932,7 @@ func (bot *Bot) CreateInvoiceLinkWithContext(ctx context.Context, title string,
 // CreateNewStickerSetOpts is the set of optional fields for Bot.CreateNewStickerSet and Bot.CreateNewStickerSetWithContext.
 type CreateNewStickerSetOpts struct {
 	// Type of stickers in the set, pass "regular", "mask", or "custom_emoji". By default, a regular sticker set is created.
-	StickerType string
+	StickerType StickerType
 	// Pass True if stickers in the sticker set must be repainted to the color of text when used in messages, the accent color if used as emoji status, white on chat photos, or another appropriate color based on context; for custom emoji sticker sets only
 	NeedsRepainting bool
 	// RequestOpts are an additional optional field to configure timeouts for individual requests
@@ -974,7 +974,7 @@ func (bot *Bot) CreateNewStickerSetWithContext(ctx context.Context, userId int64
 		v["stickers"] = string(bs)
 	}
 	if opts != nil {
-		v["sticker_type"] = opts.StickerType
+		v["sticker_type"] = string(opts.StickerType)
 		v["needs_repainting"] = strconv.FormatBool(opts.NeedsRepainting)
 	}
 
@@ -3775,17 +3775,17 @@ type SendChatActionOpts struct {
 // Use this method when you need to tell the user that something is happening on the bot's side. The status is set for 5 seconds or less (when a message arrives from your bot, Telegram clients clear its typing status). Returns True on success.
 // We only recommend using this method when a response from the bot will take a noticeable amount of time to arrive.
 //   - chatId (type int64): Unique identifier for the target chat
-//   - action (type string): Type of action to broadcast. Choose one, depending on what the user is about to receive: typing for text messages, upload_photo for photos, record_video or upload_video for videos, record_voice or upload_voice for voice notes, upload_document for general files, choose_sticker for stickers, find_location for location data, record_video_note or upload_video_note for video notes.
+//   - action (type ChatAction): Type of action to broadcast. Choose one, depending on what the user is about to receive: typing for text messages, upload_photo for photos, record_video or upload_video for videos, record_voice or upload_voice for voice notes, upload_document for general files, choose_sticker for stickers, find_location for location data, record_video_note or upload_video_note for video notes.
 //   - opts (type SendChatActionOpts): All optional parameters.
-func (bot *Bot) SendChatAction(chatId int64, action string, opts *SendChatActionOpts) (bool, error) {
+func (bot *Bot) SendChatAction(chatId int64, action ChatAction, opts *SendChatActionOpts) (bool, error) {
 	return bot.SendChatActionWithContext(context.Background(), chatId, action, opts)
 }
 
 // SendChatActionWithContext is the same as Bot.SendChatAction, but with a context.Context parameter
-func (bot *Bot) SendChatActionWithContext(ctx context.Context, chatId int64, action string, opts *SendChatActionOpts) (bool, error) {
+func (bot *Bot) SendChatActionWithContext(ctx context.Context, chatId int64, action ChatAction, opts *SendChatActionOpts) (bool, error) {
 	v := map[string]string{}
 	v["chat_id"] = strconv.FormatInt(chatId, 10)
-	v["action"] = action
+	v["action"] = string(action)
 	if opts != nil {
 		v["business_connection_id"] = opts.BusinessConnectionId
 		if opts.MessageThreadId != 0 {
@@ -4852,7 +4852,7 @@ type SendPollOpts struct {
 	// True, if the poll needs to be anonymous, defaults to True
 	IsAnonymous bool
 	// Poll type, "quiz" or "regular", defaults to "regular"
-	Type string
+	Type PollType
 	// True, if the poll allows multiple answers, ignored for polls in quiz mode, defaults to False
 	AllowsMultipleAnswers bool
 	// 0-based identifier of the correct answer option, required for polls in quiz mode
@@ -4922,7 +4922,7 @@ func (bot *Bot) SendPollWithContext(ctx context.Context, chatId int64, question
 			v["question_entities"] = string(bs)
 		}
 		v["is_anonymous"] = strconv.FormatBool(opts.IsAnonymous)
-		v["type"] = opts.Type
+		v["type"] = string(opts.Type)
 		v["allows_multiple_answers"] = strconv.FormatBool(opts.AllowsMultipleAnswers)
 		if opts.Type == "quiz" {
 			// correct_option_id should always be set when the type is "quiz" - it doesnt need to be set for type "regular".
@@ -6373,19 +6373,19 @@ type SetStickerSetThumbnailOpts struct {
 // Use this method to set the thumbnail of a regular or mask sticker set. The format of the thumbnail file must match the format of the stickers in the set. Returns True on success.
 //   - name (type string): Sticker set name
 //   - userId (type int64): User identifier of the sticker set owner
-//   - format (type string): Format of the thumbnail, must be one of "static" for a .WEBP or .PNG image, "animated" for a .TGS animation, or "video" for a WEBM video
+//   - format (type InputStickerFormat): Format of the thumbnail, must be one of "static" for a .WEBP or .PNG image, "animated" for a .TGS animation, or "video" for a WEBM video
 //   - opts (type SetStickerSetThumbnailOpts): All optional parameters.
-func (bot *Bot) SetStickerSetThumbnail(name string, userId int64, format string, opts *SetStickerSetThumbnailOpts) (bool, error) {
+func (bot *Bot) SetStickerSetThumbnail(name string, userId int64, format InputStickerFormat, opts *SetStickerSetThumbnailOpts) (bool, error) {
 	return bot.SetStickerSetThumbnailWithContext(context.Background(), name, userId, format, opts)
 }
 
 // SetStickerSetThumbnailWithContext is the same as Bot.SetStickerSetThumbnail, but with a context.Context parameter
-func (bot *Bot) SetStickerSetThumbnailWithContext(ctx context.Context, name string, userId int64, format string, opts *SetStickerSetThumbnailOpts) (bool, error) {
+func (bot *Bot) SetStickerSetThumbnailWithContext(ctx context.Context, name string, userId int64, format InputStickerFormat, opts *SetStickerSetThumbnailOpts) (bool, error) {
 	v := map[string]string{}
 	data := map[string]FileReader{}
 	v["name"] = name
 	v["user_id"] = strconv.FormatInt(userId, 10)
-	v["format"] = format
+	v["format"] = string(format)
 	if opts != nil {
 		if opts.Thumbnail != nil {
 			err := opts.Thumbnail.Attach("thumbnail", data)
@@ -6943,14 +6943,14 @@ type UploadStickerFileOpts struct {
 // Use this method to upload a file with a sticker for later use in the createNewStickerSet, addStickerToSet, or replaceStickerInSet methods (the file can be used multiple times). Returns the uploaded File on success.
 //   - userId (type int64): User identifier of sticker file owner
 //   - sticker (type InputFile): A file with the sticker in .WEBP, .PNG, .TGS, or .WEBM format. See https://core.telegram.org/stickers for technical requirements. More information on Sending Files: https://core.telegram.org/bots/api#sending-files
-//   - stickerFormat (type string): Format of the sticker, must be one of "static", "animated", "video"
+//   - stickerFormat (type InputStickerFormat): Format of the sticker, must be one of "static", "animated", "video"
 //   - opts (type UploadStickerFileOpts): All optional parameters.
-func (bot *Bot) UploadStickerFile(userId int64, sticker InputFile, stickerFormat string, opts *UploadStickerFileOpts) (*File, error) {
+func (bot *Bot) UploadStickerFile(userId int64, sticker InputFile, stickerFormat InputStickerFormat, opts *UploadStickerFileOpts) (*File, error) {
 	return bot.UploadStickerFileWithContext(context.Background(), userId, sticker, stickerFormat, opts)
 }
 
 // UploadStickerFileWithContext is the same as Bot.UploadStickerFile, but with a context.Context parameter
-func (bot *Bot) UploadStickerFileWithContext(ctx context.Context, userId int64, sticker InputFile, stickerFormat string, opts *UploadStickerFileOpts) (*File, error) {
+func (bot *Bot) UploadStickerFileWithContext(ctx context.Context, userId int64, sticker InputFile, stickerFormat InputStickerFormat, opts *UploadStickerFileOpts) (*File, error) {
 	v := map[string]string{}
 	data := map[string]FileReader{}
 	v["user_id"] = strconv.FormatInt(userId, 10)
@@ -6961,7 +6961,7 @@ func (bot *Bot) UploadStickerFileWithContext(ctx context.Context, userId int64,
 		}
 		v["sticker"] = sticker.getValue()
 	}
-	v["sticker_format"] = stickerFormat
+	v["sticker_format"] = string(stickerFormat)
 
 	var reqOpts *RequestOpts
 	if opts != nil {
@@ -76,7 +76,7 @@ type Audio struct {
 //   - BackgroundFillGradient
 //   - BackgroundFillFreeformGradient
 type BackgroundFill interface {
-	GetType() string
+	GetType() BackgroundFillType
 	// MergeBackgroundFill returns a MergedBackgroundFill struct to simplify working with complex telegram types in a non-generic world.
 	MergeBackgroundFill() MergedBackgroundFill
 	// backgroundFill exists to avoid external types implementing this interface.
@@ -93,7 +93,7 @@ var (
 // MergedBackgroundFill is a helper type to simplify interactions with the various BackgroundFill subtypes.
 type MergedBackgroundFill struct {
 	// Type of the background fill
-	Type string `json:"type"`
+	Type BackgroundFillType `json:"type"`
 	// Optional. The color of the background fill in the RGB24 format (Only for solid)
 	Color int64 `json:"color,omitempty"`
 	// Optional. Top color of the gradient in the RGB24 format (Only for gradient)
@@ -107,7 +107,7 @@ type MergedBackgroundFill struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MergedBackgroundFill) GetType() string {
+func (v MergedBackgroundFill) GetType() BackgroundFillType {
 	return v.Type
 }
 
@@ -152,7 +152,7 @@ func unmarshalBackgroundFill(d json.RawMessage) (BackgroundFill, error) {
 	}
 
 	t := struct {
-		Type string
+		Type BackgroundFillType
 	}{}
 	err := json.Unmarshal(d, &t)
 	if err != nil {
@@ -185,7 +185,48 @@ func unmarshalBackgroundFill(d json.RawMessage) (BackgroundFill, error) {
 		return s, nil
 
 	}
-	return nil, fmt.Errorf("unknown interface for BackgroundFill with Type %v", t.Type)
+	return UnknownBackgroundFill{
+		Type: t.Type,
+		Raw:  d,
+	}, nil
+}
+
+// UnknownBackgroundFill is a BackgroundFill with a Type which isn't known to this version of the library; for
+// example, when telegram adds a new BackgroundFill type. The raw JSON data is kept, so that it can still be inspected and
+// marshalled back to JSON.
+type UnknownBackgroundFill struct {
+	// Type is the type of the BackgroundFill, as sent by telegram.
+	Type BackgroundFillType
+	// Raw contains the raw JSON data of the BackgroundFill.
+	Raw json.RawMessage
+}
+
+// Ensure that the unknown type correctly implements the parent interface.
+var _ BackgroundFill = UnknownBackgroundFill{}
+
+// GetType is a helper method to easily access the common fields of an interface.
+func (v UnknownBackgroundFill) GetType() BackgroundFillType {
+	return v.Type
+}
+
+// MergeBackgroundFill returns a MergedBackgroundFill struct containing all the known fields of the BackgroundFill.
+// Fields which can't be decoded are left empty.
+func (v UnknownBackgroundFill) MergeBackgroundFill() MergedBackgroundFill {
+	m := MergedBackgroundFill{}
+	_ = json.Unmarshal(v.Raw, &m)
+	m.Type = v.Type
+	return m
+}
+
+// UnknownBackgroundFill.backgroundFill is a dummy method to avoid interface implementation.
+func (v UnknownBackgroundFill) backgroundFill() {}
+
+// MarshalJSON returns the raw JSON data of the BackgroundFill, so that it can be marshalled back without losing any fields.
+func (v UnknownBackgroundFill) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
 }
 
 // BackgroundFillFreeformGradient (https://core.telegram.org/bots/api#backgroundfillfreeformgradient)
@@ -197,7 +238,7 @@ type BackgroundFillFreeformGradient struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v BackgroundFillFreeformGradient) GetType() string {
+func (v BackgroundFillFreeformGradient) GetType() BackgroundFillType {
 	return "freeform_gradient"
 }
 
@@ -238,7 +279,7 @@ type BackgroundFillGradient struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v BackgroundFillGradient) GetType() string {
+func (v BackgroundFillGradient) GetType() BackgroundFillType {
 	return "gradient"
 }
 
@@ -277,7 +318,7 @@ type BackgroundFillSolid struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v BackgroundFillSolid) GetType() string {
+func (v BackgroundFillSolid) GetType() BackgroundFillType {
 	return "solid"
 }
 
@@ -313,7 +354,7 @@ func (v BackgroundFillSolid) backgroundFill() {}
 //   - BackgroundTypePattern
 //   - BackgroundTypeChatTheme
 type BackgroundType interface {
-	GetType() string
+	GetType() BackgroundTypeType
 	// MergeBackgroundType returns a MergedBackgroundType struct to simplify working with complex telegram types in a non-generic world.
 	MergeBackgroundType() MergedBackgroundType
 	// backgroundType exists to avoid external types implementing this interface.
@@ -331,7 +372,7 @@ var (
 // MergedBackgroundType is a helper type to simplify interactions with the various BackgroundType subtypes.
 type MergedBackgroundType struct {
 	// Type of the background
-	Type string `json:"type"`
+	Type BackgroundTypeType `json:"type"`
 	// Optional. The background fill (Only for fill, pattern)
 	Fill BackgroundFill `json:"fill,omitempty"`
 	// Optional. Dimming of the background in dark themes, as a percentage; 0-100 (Only for fill, wallpaper)
@@ -351,7 +392,7 @@ type MergedBackgroundType struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MergedBackgroundType) GetType() string {
+func (v MergedBackgroundType) GetType() BackgroundTypeType {
 	return v.Type
 }
 
@@ -396,7 +437,7 @@ func unmarshalBackgroundType(d json.RawMessage) (BackgroundType, error) {
 	}
 
 	t := struct {
-		Type string
+		Type BackgroundTypeType
 	}{}
 	err := json.Unmarshal(d, &t)
 	if err != nil {
@@ -437,7 +478,48 @@ func unmarshalBackgroundType(d json.RawMessage) (BackgroundType, error) {
 		return s, nil
 
 	}
-	return nil, fmt.Errorf("unknown interface for BackgroundType with Type %v", t.Type)
+	return UnknownBackgroundType{
+		Type: t.Type,
+		Raw:  d,
+	}, nil
+}
+
+// UnknownBackgroundType is a BackgroundType with a Type which isn't known to this version of the library; for
+// example, when telegram adds a new BackgroundType type. The raw JSON data is kept, so that it can still be inspected and
+// marshalled back to JSON.
+type UnknownBackgroundType struct {
+	// Type is the type of the BackgroundType, as sent by telegram.
+	Type BackgroundTypeType
+	// Raw contains the raw JSON data of the BackgroundType.
+	Raw json.RawMessage
+}
+
+// Ensure that the unknown type correctly implements the parent interface.
+var _ BackgroundType = UnknownBackgroundType{}
+
+// GetType is a helper method to easily access the common fields of an interface.
+func (v UnknownBackgroundType) GetType() BackgroundTypeType {
+	return v.Type
+}
+
+// MergeBackgroundType returns a MergedBackgroundType struct containing all the known fields of the BackgroundType.
+// Fields which can't be decoded are left empty.
+func (v UnknownBackgroundType) MergeBackgroundType() MergedBackgroundType {
+	m := MergedBackgroundType{}
+	_ = json.Unmarshal(v.Raw, &m)
+	m.Type = v.Type
+	return m
+}
+
+// UnknownBackgroundType.backgroundType is a dummy method to avoid interface implementation.
+func (v UnknownBackgroundType) backgroundType() {}
+
+// MarshalJSON returns the raw JSON data of the BackgroundType, so that it can be marshalled back without losing any fields.
+func (v UnknownBackgroundType) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
 }
 
 // BackgroundTypeChatTheme (https://core.telegram.org/bots/api#backgroundtypechattheme)
@@ -449,7 +531,7 @@ type BackgroundTypeChatTheme struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v BackgroundTypeChatTheme) GetType() string {
+func (v BackgroundTypeChatTheme) GetType() BackgroundTypeType {
 	return "chat_theme"
 }
 
@@ -510,7 +592,7 @@ func (v *BackgroundTypeFill) UnmarshalJSON(b []byte) error {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v BackgroundTypeFill) GetType() string {
+func (v BackgroundTypeFill) GetType() BackgroundTypeType {
 	return "fill"
 }
 
@@ -584,7 +666,7 @@ func (v *BackgroundTypePattern) UnmarshalJSON(b []byte) error {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v BackgroundTypePattern) GetType() string {
+func (v BackgroundTypePattern) GetType() BackgroundTypeType {
 	return "pattern"
 }
 
@@ -631,7 +713,7 @@ type BackgroundTypeWallpaper struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v BackgroundTypeWallpaper) GetType() string {
+func (v BackgroundTypeWallpaper) GetType() BackgroundTypeType {
 	return "wallpaper"
 }
 
@@ -695,7 +777,7 @@ type BotCommand struct {
 //   - BotCommandScopeChatAdministrators
 //   - BotCommandScopeChatMember
 type BotCommandScope interface {
-	GetType() string
+	GetType() BotCommandScopeType
 	// MergeBotCommandScope returns a MergedBotCommandScope struct to simplify working with complex telegram types in a non-generic world.
 	MergeBotCommandScope() MergedBotCommandScope
 	// botCommandScope exists to avoid external types implementing this interface.
@@ -716,7 +798,7 @@ var (
 // MergedBotCommandScope is a helper type to simplify interactions with the various BotCommandScope subtypes.
 type MergedBotCommandScope struct {
 	// Scope type
-	Type string `json:"type"`
+	Type BotCommandScopeType `json:"type"`
 	// Optional. Unique identifier for the target chat (Only for chat, chat_administrators, chat_member)
 	ChatId int64 `json:"chat_id,omitempty"`
 	// Optional. Unique identifier of the target user (Only for chat_member)
@@ -724,7 +806,7 @@ type MergedBotCommandScope struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MergedBotCommandScope) GetType() string {
+func (v MergedBotCommandScope) GetType() BotCommandScopeType {
 	return v.Type
 }
 
@@ -742,7 +824,7 @@ func (v MergedBotCommandScope) MergeBotCommandScope() MergedBotCommandScope {
 type BotCommandScopeAllChatAdministrators struct{}
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v BotCommandScopeAllChatAdministrators) GetType() string {
+func (v BotCommandScopeAllChatAdministrators) GetType() BotCommandScopeType {
 	return "all_chat_administrators"
 }
 
@@ -775,7 +857,7 @@ func (v BotCommandScopeAllChatAdministrators) botCommandScope() {}
 type BotCommandScopeAllGroupChats struct{}
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v BotCommandScopeAllGroupChats) GetType() string {
+func (v BotCommandScopeAllGroupChats) GetType() BotCommandScopeType {
 	return "all_group_chats"
 }
 
@@ -808,7 +890,7 @@ func (v BotCommandScopeAllGroupChats) botCommandScope() {}
 type BotCommandScopeAllPrivateChats struct{}
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v BotCommandScopeAllPrivateChats) GetType() string {
+func (v BotCommandScopeAllPrivateChats) GetType() BotCommandScopeType {
 	return "all_private_chats"
 }
 
@@ -844,7 +926,7 @@ type BotCommandScopeChat struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v BotCommandScopeChat) GetType() string {
+func (v BotCommandScopeChat) GetType() BotCommandScopeType {
 	return "chat"
 }
 
@@ -881,7 +963,7 @@ type BotCommandScopeChatAdministrators struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v BotCommandScopeChatAdministrators) GetType() string {
+func (v BotCommandScopeChatAdministrators) GetType() BotCommandScopeType {
 	return "chat_administrators"
 }
 
@@ -920,7 +1002,7 @@ type BotCommandScopeChatMember struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v BotCommandScopeChatMember) GetType() string {
+func (v BotCommandScopeChatMember) GetType() BotCommandScopeType {
 	return "chat_member"
 }
 
@@ -955,7 +1037,7 @@ func (v BotCommandScopeChatMember) botCommandScope() {}
 type BotCommandScopeDefault struct{}
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v BotCommandScopeDefault) GetType() string {
+func (v BotCommandScopeDefault) GetType() BotCommandScopeType {
 	return "default"
 }
 
@@ -1142,7 +1224,7 @@ type Chat struct {
 	// Unique identifier for this chat. This number may have more than 32 significant bits and some programming languages may have difficulty/silent defects in interpreting it. But it has at most 52 significant bits, so a signed 64-bit integer or double-precision float type are safe for storing this identifier.
 	Id int64 `json:"id"`
 	// Type of the chat, can be either "private", "group", "supergroup" or "channel"
-	Type string `json:"type"`
+	Type ChatType `json:"type"`
 	// Optional. Title, for supergroups, channels and group chats
 	Title string `json:"title,omitempty"`
 	// Optional. Username, for private chats, supergroups and channels if available
@@ -1314,7 +1396,7 @@ func (v *ChatBoostRemoved) UnmarshalJSON(b []byte) error {
 //   - ChatBoostSourceGiftCode
 //   - ChatBoostSourceGiveaway
 type ChatBoostSource interface {
-	GetSource() string
+	GetSource() ChatBoostSourceSource
 	// MergeChatBoostSource returns a MergedChatBoostSource struct to simplify working with complex telegram types in a non-generic world.
 	MergeChatBoostSource() MergedChatBoostSource
 	// chatBoostSource exists to avoid external types implementing this interface.
@@ -1331,7 +1413,7 @@ var (
 // MergedChatBoostSource is a helper type to simplify interactions with the various ChatBoostSource subtypes.
 type MergedChatBoostSource struct {
 	// Source of the boost
-	Source string `json:"source"`
+	Source ChatBoostSourceSource `json:"source"`
 	// Optional. User that provided the boost (may be empty for ChatBoostSourceGiveaway)
 	User *User `json:"user,omitempty"`
 	// Optional. Identifier of a message in the chat with the giveaway; the message could have been deleted already. May be 0 if the message isn't sent yet. (Only for giveaway)
@@ -1343,7 +1425,7 @@ type MergedChatBoostSource struct {
 }
 
 // GetSource is a helper method to easily access the common fields of an interface.
-func (v MergedChatBoostSource) GetSource() string {
+func (v MergedChatBoostSource) GetSource() ChatBoostSourceSource {
 	return v.Source
 }
 
@@ -1388,7 +1470,7 @@ func unmarshalChatBoostSource(d json.RawMessage) (ChatBoostSource, error) {
 	}
 
 	t := struct {
-		Source string
+		Source ChatBoostSourceSource
 	}{}
 	err := json.Unmarshal(d, &t)
 	if err != nil {
@@ -1421,7 +1503,48 @@ func unmarshalChatBoostSource(d json.RawMessage) (ChatBoostSource, error) {
 		return s, nil
 
 	}
-	return nil, fmt.Errorf("unknown interface for ChatBoostSource with Source %v", t.Source)
+	return UnknownChatBoostSource{
+		Source: t.Source,
+		Raw:    d,
+	}, nil
+}
+
+// UnknownChatBoostSource is a ChatBoostSource with a Source which isn't known to this version of the library; for
+// example, when telegram adds a new ChatBoostSource type. The raw JSON data is kept, so that it can still be inspected and
+// marshalled back to JSON.
+type UnknownChatBoostSource struct {
+	// Source is the source of the ChatBoostSource, as sent by telegram.
+	Source ChatBoostSourceSource
+	// Raw contains the raw JSON data of the ChatBoostSource.
+	Raw json.RawMessage
+}
+
+// Ensure that the unknown type correctly implements the parent interface.
+var _ ChatBoostSource = UnknownChatBoostSource{}
+
+// GetSource is a helper method to easily access the common fields of an interface.
+func (v UnknownChatBoostSource) GetSource() ChatBoostSourceSource {
+	return v.Source
+}
+
+// MergeChatBoostSource returns a MergedChatBoostSource struct containing all the known fields of the ChatBoostSource.
+// Fields which can't be decoded are left empty.
+func (v UnknownChatBoostSource) MergeChatBoostSource() MergedChatBoostSource {
+	m := MergedChatBoostSource{}
+	_ = json.Unmarshal(v.Raw, &m)
+	m.Source = v.Source
+	return m
+}
+
+// UnknownChatBoostSource.chatBoostSource is a dummy method to avoid interface implementation.
+func (v UnknownChatBoostSource) chatBoostSource() {}
+
+// MarshalJSON returns the raw JSON data of the ChatBoostSource, so that it can be marshalled back without losing any fields.
+func (v UnknownChatBoostSource) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
 }
 
 // ChatBoostSourceGiftCode (https://core.telegram.org/bots/api#chatboostsourcegiftcode)
@@ -1433,7 +1556,7 @@ type ChatBoostSourceGiftCode struct {
 }
 
 // GetSource is a helper method to easily access the common fields of an interface.
-func (v ChatBoostSourceGiftCode) GetSource() string {
+func (v ChatBoostSourceGiftCode) GetSource() ChatBoostSourceSource {
 	return "gift_code"
 }
 
@@ -1476,7 +1599,7 @@ type ChatBoostSourceGiveaway struct {
 }
 
 // GetSource is a helper method to easily access the common fields of an interface.
-func (v ChatBoostSourceGiveaway) GetSource() string {
+func (v ChatBoostSourceGiveaway) GetSource() ChatBoostSourceSource {
 	return "giveaway"
 }
 
@@ -1516,7 +1639,7 @@ type ChatBoostSourcePremium struct {
 }
 
 // GetSource is a helper method to easily access the common fields of an interface.
-func (v ChatBoostSourcePremium) GetSource() string {
+func (v ChatBoostSourcePremium) GetSource() ChatBoostSourceSource {
 	return "premium"
 }
 
@@ -1561,7 +1684,7 @@ type ChatFullInfo struct {
 	// Unique identifier for this chat. This number may have more than 32 significant bits and some programming languages may have difficulty/silent defects in interpreting it. But it has at most 52 significant bits, so a signed 64-bit integer or double-precision float type are safe for storing this identifier.
 	Id int64 `json:"id"`
 	// Type of the chat, can be either "private", "group", "supergroup" or "channel"
-	Type string `json:"type"`
+	Type ChatType `json:"type"`
 	// Optional. Title, for supergroups, channels and group chats
 	Title string `json:"title,omitempty"`
 	// Optional. Username, for private chats, supergroups and channels if available
@@ -1653,7 +1776,7 @@ func (v *ChatFullInfo) UnmarshalJSON(b []byte) error {
 	// All fields in ChatFullInfo, with interface fields as json.RawMessage
 	type tmp struct {
 		Id                                 int64                 `json:"id"`
-		Type                               string                `json:"type"`
+		Type                               ChatType              `json:"type"`
 		Title                              string                `json:"title"`
 		Username                           string                `json:"username"`
 		FirstName                          string                `json:"first_name"`
@@ -1820,7 +1943,7 @@ type ChatLocation struct {
 //   - ChatMemberLeft
 //   - ChatMemberBanned
 type ChatMember interface {
-	GetStatus() string
+	GetStatus() ChatMemberStatus
 	GetUser() User
 	// MergeChatMember returns a MergedChatMember struct to simplify working with complex telegram types in a non-generic world.
 	MergeChatMember() MergedChatMember
@@ -1841,7 +1964,7 @@ var (
 // MergedChatMember is a helper type to simplify interactions with the various ChatMember subtypes.
 type MergedChatMember struct {
 	// The member's status in the chat
-	Status string `json:"status"`
+	Status ChatMemberStatus `json:"status"`
 	// Information about the user
 	User User `json:"user"`
 	// Optional. True, if the user's presence in the chat is hidden (Only for creator, administrator)
@@ -1905,7 +2028,7 @@ type MergedChatMember struct {
 }
 
 // GetStatus is a helper method to easily access the common fields of an interface.
-func (v MergedChatMember) GetStatus() string {
+func (v MergedChatMember) GetStatus() ChatMemberStatus {
 	return v.Status
 }
 
@@ -1955,7 +2078,7 @@ func unmarshalChatMember(d json.RawMessage) (ChatMember, error) {
 	}
 
 	t := struct {
-		Status string
+		Status ChatMemberStatus
 	}{}
 	err := json.Unmarshal(d, &t)
 	if err != nil {
@@ -2012,7 +2135,53 @@ func unmarshalChatMember(d json.RawMessage) (ChatMember, error) {
 		return s, nil
 
 	}
-	return nil, fmt.Errorf("unknown interface for ChatMember with Status %v", t.Status)
+	return UnknownChatMember{
+		Status: t.Status,
+		Raw:    d,
+	}, nil
+}
+
+// UnknownChatMember is a ChatMember with a Status which isn't known to this version of the library; for
+// example, when telegram adds a new ChatMember type. The raw JSON data is kept, so that it can still be inspected and
+// marshalled back to JSON.
+type UnknownChatMember struct {
+	// Status is the status of the ChatMember, as sent by telegram.
+	Status ChatMemberStatus
+	// Raw contains the raw JSON data of the ChatMember.
+	Raw json.RawMessage
+}
+
+// Ensure that the unknown type correctly implements the parent interface.
+var _ ChatMember = UnknownChatMember{}
+
+// GetStatus is a helper method to easily access the common fields of an interface.
+func (v UnknownChatMember) GetStatus() ChatMemberStatus {
+	return v.Status
+}
+
+// GetUser is a helper method to easily access the common fields of an interface.
+func (v UnknownChatMember) GetUser() User {
+	return v.MergeChatMember().GetUser()
+}
+
+// MergeChatMember returns a MergedChatMember struct containing all the known fields of the ChatMember.
+// Fields which can't be decoded are left empty.
+func (v UnknownChatMember) MergeChatMember() MergedChatMember {
+	m := MergedChatMember{}
+	_ = json.Unmarshal(v.Raw, &m)
+	m.Status = v.Status
+	return m
+}
+
+// UnknownChatMember.chatMember is a dummy method to avoid interface implementation.
+func (v UnknownChatMember) chatMember() {}
+
+// MarshalJSON returns the raw JSON data of the ChatMember, so that it can be marshalled back without losing any fields.
+func (v UnknownChatMember) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
 }
 
 // ChatMemberAdministrator (https://core.telegram.org/bots/api#chatmemberadministrator)
@@ -2058,7 +2227,7 @@ type ChatMemberAdministrator struct {
 }
 
 // GetStatus is a helper method to easily access the common fields of an interface.
-func (v ChatMemberAdministrator) GetStatus() string {
+func (v ChatMemberAdministrator) GetStatus() ChatMemberStatus {
 	return "administrator"
 }
 
@@ -2119,7 +2288,7 @@ type ChatMemberBanned struct {
 }
 
 // GetStatus is a helper method to easily access the common fields of an interface.
-func (v ChatMemberBanned) GetStatus() string {
+func (v ChatMemberBanned) GetStatus() ChatMemberStatus {
 	return "kicked"
 }
 
@@ -2162,7 +2331,7 @@ type ChatMemberLeft struct {
 }
 
 // GetStatus is a helper method to easily access the common fields of an interface.
-func (v ChatMemberLeft) GetStatus() string {
+func (v ChatMemberLeft) GetStatus() ChatMemberStatus {
 	return "left"
 }
 
@@ -2206,7 +2375,7 @@ type ChatMemberMember struct {
 }
 
 // GetStatus is a helper method to easily access the common fields of an interface.
-func (v ChatMemberMember) GetStatus() string {
+func (v ChatMemberMember) GetStatus() ChatMemberStatus {
 	return "member"
 }
 
@@ -2253,7 +2422,7 @@ type ChatMemberOwner struct {
 }
 
 // GetStatus is a helper method to easily access the common fields of an interface.
-func (v ChatMemberOwner) GetStatus() string {
+func (v ChatMemberOwner) GetStatus() ChatMemberStatus {
 	return "creator"
 }
 
@@ -2329,7 +2498,7 @@ type ChatMemberRestricted struct {
 }
 
 // GetStatus is a helper method to easily access the common fields of an interface.
-func (v ChatMemberRestricted) GetStatus() string {
+func (v ChatMemberRestricted) GetStatus() ChatMemberStatus {
 	return "restricted"
 }
 
@@ -2587,7 +2756,7 @@ type EncryptedCredentials struct {
 // Describes documents or other Telegram Passport elements shared with the bot by the user.
 type EncryptedPassportElement struct {
 	// Element type. One of "personal_details", "passport", "driver_license", "identity_card", "internal_passport", "address", "utility_bill", "bank_statement", "rental_agreement", "passport_registration", "temporary_registration", "phone_number", "email".
-	Type string `json:"type"`
+	Type EncryptedPassportElementType `json:"type"`
 	// Optional. Base64-encoded encrypted Telegram Passport element data provided by the user; available only for "personal_details", "passport", "driver_license", "identity_card", "internal_passport" and "address" types. Can be decrypted and verified using the accompanying EncryptedCredentials.
 	Data string `json:"data,omitempty"`
 	// Optional. User's verified phone number; available only for "phone_number" type
@@ -3025,7 +3194,7 @@ type InlineQuery struct {
 	// Offset of the results to be returned, can be controlled by the bot
 	Offset string `json:"offset"`
 	// Optional. Type of the chat from which the inline query was sent. Can be either "sender" for a private chat with the inline query sender, "private", "group", "supergroup", or "channel". The chat type should be always known for requests sent from official clients and most third-party clients, unless the request was sent from a secret chat
-	ChatType string `json:"chat_type,omitempty"`
+	ChatType InlineQueryChatType `json:"chat_type,omitempty"`
 	// Optional. Sender location, only for bots that request user location
 	Location *Location `json:"location,omitempty"`
 }
@@ -3056,7 +3225,7 @@ type InlineQuery struct {
 //
 // Note: All URLs passed in inline query results will be available to end users and therefore must be assumed to be public.
 type InlineQueryResult interface {
-	GetType() string
+	GetType() InlineQueryResultType
 	GetId() string
 	// MergeInlineQueryResult returns a MergedInlineQueryResult struct to simplify working with complex telegram types in a non-generic world.
 	MergeInlineQueryResult() MergedInlineQueryResult
@@ -3091,7 +3260,7 @@ var (
 // MergedInlineQueryResult is a helper type to simplify interactions with the various InlineQueryResult subtypes.
 type MergedInlineQueryResult struct {
 	// Type of the result
-	Type string `json:"type"`
+	Type InlineQueryResultType `json:"type"`
 	// Unique identifier for this result, 1-64 bytes
 	Id string `json:"id"`
 	// Optional. A valid file identifier for the audio file (Only for audio)
@@ -3217,7 +3386,7 @@ type MergedInlineQueryResult struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MergedInlineQueryResult) GetType() string {
+func (v MergedInlineQueryResult) GetType() InlineQueryResultType {
 	return v.Type
 }
 
@@ -3261,7 +3430,7 @@ type InlineQueryResultArticle struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultArticle) GetType() string {
+func (v InlineQueryResultArticle) GetType() InlineQueryResultType {
 	return "article"
 }
 
@@ -3330,7 +3499,7 @@ type InlineQueryResultAudio struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultAudio) GetType() string {
+func (v InlineQueryResultAudio) GetType() InlineQueryResultType {
 	return "audio"
 }
 
@@ -3393,7 +3562,7 @@ type InlineQueryResultCachedAudio struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultCachedAudio) GetType() string {
+func (v InlineQueryResultCachedAudio) GetType() InlineQueryResultType {
 	return "audio"
 }
 
@@ -3457,7 +3626,7 @@ type InlineQueryResultCachedDocument struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultCachedDocument) GetType() string {
+func (v InlineQueryResultCachedDocument) GetType() InlineQueryResultType {
 	return "document"
 }
 
@@ -3523,7 +3692,7 @@ type InlineQueryResultCachedGif struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultCachedGif) GetType() string {
+func (v InlineQueryResultCachedGif) GetType() InlineQueryResultType {
 	return "gif"
 }
 
@@ -3589,7 +3758,7 @@ type InlineQueryResultCachedMpeg4Gif struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultCachedMpeg4Gif) GetType() string {
+func (v InlineQueryResultCachedMpeg4Gif) GetType() InlineQueryResultType {
 	return "mpeg4_gif"
 }
 
@@ -3657,7 +3826,7 @@ type InlineQueryResultCachedPhoto struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultCachedPhoto) GetType() string {
+func (v InlineQueryResultCachedPhoto) GetType() InlineQueryResultType {
 	return "photo"
 }
 
@@ -3714,7 +3883,7 @@ type InlineQueryResultCachedSticker struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultCachedSticker) GetType() string {
+func (v InlineQueryResultCachedSticker) GetType() InlineQueryResultType {
 	return "sticker"
 }
 
@@ -3777,7 +3946,7 @@ type InlineQueryResultCachedVideo struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultCachedVideo) GetType() string {
+func (v InlineQueryResultCachedVideo) GetType() InlineQueryResultType {
 	return "video"
 }
 
@@ -3842,7 +4011,7 @@ type InlineQueryResultCachedVoice struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultCachedVoice) GetType() string {
+func (v InlineQueryResultCachedVoice) GetType() InlineQueryResultType {
 	return "voice"
 }
 
@@ -3909,7 +4078,7 @@ type InlineQueryResultContact struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultContact) GetType() string {
+func (v InlineQueryResultContact) GetType() InlineQueryResultType {
 	return "contact"
 }
 
@@ -3984,7 +4153,7 @@ type InlineQueryResultDocument struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultDocument) GetType() string {
+func (v InlineQueryResultDocument) GetType() InlineQueryResultType {
 	return "document"
 }
 
@@ -4042,7 +4211,7 @@ type InlineQueryResultGame struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultGame) GetType() string {
+func (v InlineQueryResultGame) GetType() InlineQueryResultType {
 	return "game"
 }
 
@@ -4112,7 +4281,7 @@ type InlineQueryResultGif struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultGif) GetType() string {
+func (v InlineQueryResultGif) GetType() InlineQueryResultType {
 	return "gif"
 }
 
@@ -4191,7 +4360,7 @@ type InlineQueryResultLocation struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultLocation) GetType() string {
+func (v InlineQueryResultLocation) GetType() InlineQueryResultType {
 	return "location"
 }
 
@@ -4271,7 +4440,7 @@ type InlineQueryResultMpeg4Gif struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultMpeg4Gif) GetType() string {
+func (v InlineQueryResultMpeg4Gif) GetType() InlineQueryResultType {
 	return "mpeg4_gif"
 }
 
@@ -4350,7 +4519,7 @@ type InlineQueryResultPhoto struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultPhoto) GetType() string {
+func (v InlineQueryResultPhoto) GetType() InlineQueryResultType {
 	return "photo"
 }
 
@@ -4430,7 +4599,7 @@ type InlineQueryResultVenue struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultVenue) GetType() string {
+func (v InlineQueryResultVenue) GetType() InlineQueryResultType {
 	return "venue"
 }
 
@@ -4513,7 +4682,7 @@ type InlineQueryResultVideo struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultVideo) GetType() string {
+func (v InlineQueryResultVideo) GetType() InlineQueryResultType {
 	return "video"
 }
 
@@ -4585,7 +4754,7 @@ type InlineQueryResultVoice struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InlineQueryResultVoice) GetType() string {
+func (v InlineQueryResultVoice) GetType() InlineQueryResultType {
 	return "voice"
 }
 
@@ -4737,7 +4906,7 @@ func (v InputLocationMessageContent) inputMessageContent() {}
 //   - InputMediaPhoto
 //   - InputMediaVideo
 type InputMedia interface {
-	GetType() string
+	GetType() InputMediaType
 	GetMedia() InputFileOrString
 	// InputParams allows for uploading attachments with files.
 	InputParams(string, map[string]FileReader) ([]byte, error)
@@ -4759,7 +4928,7 @@ var (
 // MergedInputMedia is a helper type to simplify interactions with the various InputMedia subtypes.
 type MergedInputMedia struct {
 	// Type of the result
-	Type string `json:"type"`
+	Type InputMediaType `json:"type"`
 	// File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass "attach://<file_attach_name>" to upload a new one using multipart/form-data under <file_attach_name> name. More information on Sending Files: https://core.telegram.org/bots/api#sending-files
 	Media InputFileOrString `json:"media"`
 	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More information on Sending Files: https://core.telegram.org/bots/api#sending-files (Only for animation, document, audio, video)
@@ -4791,7 +4960,7 @@ type MergedInputMedia struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MergedInputMedia) GetType() string {
+func (v MergedInputMedia) GetType() InputMediaType {
 	return v.Type
 }
 
@@ -4835,7 +5004,7 @@ type InputMediaAnimation struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InputMediaAnimation) GetType() string {
+func (v InputMediaAnimation) GetType() InputMediaType {
 	return "animation"
 }
 
@@ -4918,7 +5087,7 @@ type InputMediaAudio struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InputMediaAudio) GetType() string {
+func (v InputMediaAudio) GetType() InputMediaType {
 	return "audio"
 }
 
@@ -4995,7 +5164,7 @@ type InputMediaDocument struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InputMediaDocument) GetType() string {
+func (v InputMediaDocument) GetType() InputMediaType {
 	return "document"
 }
 
@@ -5070,7 +5239,7 @@ type InputMediaPhoto struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InputMediaPhoto) GetType() string {
+func (v InputMediaPhoto) GetType() InputMediaType {
 	return "photo"
 }
 
@@ -5148,7 +5317,7 @@ type InputMediaVideo struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InputMediaVideo) GetType() string {
+func (v InputMediaVideo) GetType() InputMediaType {
 	return "video"
 }
 
@@ -5237,7 +5406,7 @@ var (
 //   - InputPaidMediaPhoto
 //   - InputPaidMediaVideo
 type InputPaidMedia interface {
-	GetType() string
+	GetType() InputPaidMediaType
 	GetMedia() InputFileOrString
 	// InputParams allows for uploading attachments with files.
 	InputParams(string, map[string]FileReader) ([]byte, error)
@@ -5256,7 +5425,7 @@ var (
 // MergedInputPaidMedia is a helper type to simplify interactions with the various InputPaidMedia subtypes.
 type MergedInputPaidMedia struct {
 	// Type of the media
-	Type string `json:"type"`
+	Type InputPaidMediaType `json:"type"`
 	// File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass "attach://<file_attach_name>" to upload a new one using multipart/form-data under <file_attach_name> name. More information on Sending Files: https://core.telegram.org/bots/api#sending-files
 	Media InputFileOrString `json:"media"`
 	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More information on Sending Files: https://core.telegram.org/bots/api#sending-files (Only for video)
@@ -5272,7 +5441,7 @@ type MergedInputPaidMedia struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MergedInputPaidMedia) GetType() string {
+func (v MergedInputPaidMedia) GetType() InputPaidMediaType {
 	return v.Type
 }
 
@@ -5298,7 +5467,7 @@ type InputPaidMediaPhoto struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InputPaidMediaPhoto) GetType() string {
+func (v InputPaidMediaPhoto) GetType() InputPaidMediaType {
 	return "photo"
 }
 
@@ -5361,7 +5530,7 @@ type InputPaidMediaVideo struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v InputPaidMediaVideo) GetType() string {
+func (v InputPaidMediaVideo) GetType() InputPaidMediaType {
 	return "video"
 }
 
@@ -5436,7 +5605,7 @@ type InputSticker struct {
 	// The added sticker. Pass a file_id as a String to send a file that already exists on the Telegram servers, pass an HTTP URL as a String for Telegram to get a file from the Internet, upload a new one using multipart/form-data, or pass "attach://<file_attach_name>" to upload a new one using multipart/form-data under <file_attach_name> name. Animated and video stickers can't be uploaded via HTTP URL. More information on Sending Files: https://core.telegram.org/bots/api#sending-files
 	Sticker InputFileOrString `json:"sticker"`
 	// Format of the added sticker, must be one of "static" for a .WEBP or .PNG image, "animated" for a .TGS animation, "video" for a WEBM video
-	Format string `json:"format"`
+	Format InputStickerFormat `json:"format"`
 	// List of 1-20 emoji associated with the sticker
 	EmojiList []string `json:"emoji_list,omitempty"`
 	// Optional. Position where the mask should be placed on faces. For "mask" stickers only.
@@ -5655,7 +5824,7 @@ type LoginUrl struct {
 // This object describes the position on faces where a mask should be placed by default.
 type MaskPosition struct {
 	// The part of the face relative to which the mask should be placed. One of "forehead", "eyes", "mouth", or "chin".
-	Point string `json:"point"`
+	Point MaskPositionPoint `json:"point"`
 	// Shift by X-axis measured in widths of the mask scaled to the face size, from left to right. For example, choosing -1.0 will place mask just to the left of the default mask position.
 	XShift float64 `json:"x_shift"`
 	// Shift by Y-axis measured in heights of the mask scaled to the face size, from top to bottom. For example, 1.0 will place the mask just below the default mask position.
@@ -5718,7 +5887,7 @@ var (
 //
 // If a menu button other than MenuButtonDefault is set for a private chat, then it is applied in the chat. Otherwise the default menu button is applied. By default, the menu button opens the list of bot commands.
 type MenuButton interface {
-	GetType() string
+	GetType() MenuButtonType
 	// MergeMenuButton returns a MergedMenuButton struct to simplify working with complex telegram types in a non-generic world.
 	MergeMenuButton() MergedMenuButton
 	// menuButton exists to avoid external types implementing this interface.
@@ -5735,7 +5904,7 @@ var (
 // MergedMenuButton is a helper type to simplify interactions with the various MenuButton subtypes.
 type MergedMenuButton struct {
 	// Type of the button
-	Type string `json:"type"`
+	Type MenuButtonType `json:"type"`
 	// Optional. Text on the button (Only for web_app)
 	Text string `json:"text,omitempty"`
 	// Optional. Description of the Web App that will be launched when the user presses the button. The Web App will be able to send an arbitrary message on behalf of the user using the method answerWebAppQuery. Alternatively, a t.me link to a Web App of the bot can be specified in the object instead of the Web App's URL, in which case the Web App will be opened as if the user pressed the link. (Only for web_app)
@@ -5743,7 +5912,7 @@ type MergedMenuButton struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MergedMenuButton) GetType() string {
+func (v MergedMenuButton) GetType() MenuButtonType {
 	return v.Type
 }
 
@@ -5788,7 +5957,7 @@ func unmarshalMenuButton(d json.RawMessage) (MenuButton, error) {
 	}
 
 	t := struct {
-		Type string
+		Type MenuButtonType
 	}{}
 	err := json.Unmarshal(d, &t)
 	if err != nil {
@@ -5821,7 +5990,48 @@ func unmarshalMenuButton(d json.RawMessage) (MenuButton, error) {
 		return s, nil
 
 	}
-	return nil, fmt.Errorf("unknown interface for MenuButton with Type %v", t.Type)
+	return UnknownMenuButton{
+		Type: t.Type,
+		Raw:  d,
+	}, nil
+}
+
+// UnknownMenuButton is a MenuButton with a Type which isn't known to this version of the library; for
+// example, when telegram adds a new MenuButton type. The raw JSON data is kept, so that it can still be inspected and
+// marshalled back to JSON.
+type UnknownMenuButton struct {
+	// Type is the type of the MenuButton, as sent by telegram.
+	Type MenuButtonType
+	// Raw contains the raw JSON data of the MenuButton.
+	Raw json.RawMessage
+}
+
+// Ensure that the unknown type correctly implements the parent interface.
+var _ MenuButton = UnknownMenuButton{}
+
+// GetType is a helper method to easily access the common fields of an interface.
+func (v UnknownMenuButton) GetType() MenuButtonType {
+	return v.Type
+}
+
+// MergeMenuButton returns a MergedMenuButton struct containing all the known fields of the MenuButton.
+// Fields which can't be decoded are left empty.
+func (v UnknownMenuButton) MergeMenuButton() MergedMenuButton {
+	m := MergedMenuButton{}
+	_ = json.Unmarshal(v.Raw, &m)
+	m.Type = v.Type
+	return m
+}
+
+// UnknownMenuButton.menuButton is a dummy method to avoid interface implementation.
+func (v UnknownMenuButton) menuButton() {}
+
+// MarshalJSON returns the raw JSON data of the MenuButton, so that it can be marshalled back without losing any fields.
+func (v UnknownMenuButton) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
 }
 
 // MenuButtonCommands (https://core.telegram.org/bots/api#menubuttoncommands)
@@ -5830,7 +6040,7 @@ func unmarshalMenuButton(d json.RawMessage) (MenuButton, error) {
 type MenuButtonCommands struct{}
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MenuButtonCommands) GetType() string {
+func (v MenuButtonCommands) GetType() MenuButtonType {
 	return "commands"
 }
 
@@ -5863,7 +6073,7 @@ func (v MenuButtonCommands) menuButton() {}
 type MenuButtonDefault struct{}
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MenuButtonDefault) GetType() string {
+func (v MenuButtonDefault) GetType() MenuButtonType {
 	return "default"
 }
 
@@ -5901,7 +6111,7 @@ type MenuButtonWebApp struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MenuButtonWebApp) GetType() string {
+func (v MenuButtonWebApp) GetType() MenuButtonType {
 	return "web_app"
 }
 
@@ -6328,7 +6538,7 @@ type MessageAutoDeleteTimerChanged struct {
 // This object represents one special entity in a text message. For example, hashtags, usernames, URLs, etc.
 type MessageEntity struct {
 	// Type of the entity. Currently, can be "mention" (@username), "hashtag" (#hashtag or #hashtag@chatusername), "cashtag" ($USD or $USD@chatusername), "bot_command" (/start@jobs_bot), "url" (https://telegram.org), "email" (do-not-reply@telegram.org), "phone_number" (+1-212-555-0123), "bold" (bold text), "italic" (italic text), "underline" (underlined text), "strikethrough" (strikethrough text), "spoiler" (spoiler message), "blockquote" (block quotation), "expandable_blockquote" (collapsed-by-default block quotation), "code" (monowidth string), "pre" (monowidth block), "text_link" (for clickable text URLs), "text_mention" (for users without usernames), "custom_emoji" (for inline custom emoji stickers)
-	Type string `json:"type"`
+	Type MessageEntityType `json:"type"`
 	// Offset in UTF-16 code units to the start of the entity
 	Offset int64 `json:"offset"`
 	// Length of the entity in UTF-16 code units
@@ -6359,7 +6569,7 @@ type MessageId struct {
 //   - MessageOriginChat
 //   - MessageOriginChannel
 type MessageOrigin interface {
-	GetType() string
+	GetType() MessageOriginType
 	GetDate() int64
 	// MergeMessageOrigin returns a MergedMessageOrigin struct to simplify working with complex telegram types in a non-generic world.
 	MergeMessageOrigin() MergedMessageOrigin
@@ -6378,7 +6588,7 @@ var (
 // MergedMessageOrigin is a helper type to simplify interactions with the various MessageOrigin subtypes.
 type MergedMessageOrigin struct {
 	// Type of the message origin
-	Type string `json:"type"`
+	Type MessageOriginType `json:"type"`
 	// Date the message was sent originally in Unix time
 	Date int64 `json:"date"`
 	// Optional. User that sent the message originally (Only for user)
@@ -6396,7 +6606,7 @@ type MergedMessageOrigin struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MergedMessageOrigin) GetType() string {
+func (v MergedMessageOrigin) GetType() MessageOriginType {
 	return v.Type
 }
 
@@ -6446,7 +6656,7 @@ func unmarshalMessageOrigin(d json.RawMessage) (MessageOrigin, error) {
 	}
 
 	t := struct {
-		Type string
+		Type MessageOriginType
 	}{}
 	err := json.Unmarshal(d, &t)
 	if err != nil {
@@ -6487,7 +6697,53 @@ func unmarshalMessageOrigin(d json.RawMessage) (MessageOrigin, error) {
 		return s, nil
 
 	}
-	return nil, fmt.Errorf("unknown interface for MessageOrigin with Type %v", t.Type)
+	return UnknownMessageOrigin{
+		Type: t.Type,
+		Raw:  d,
+	}, nil
+}
+
+// UnknownMessageOrigin is a MessageOrigin with a Type which isn't known to this version of the library; for
+// example, when telegram adds a new MessageOrigin type. The raw JSON data is kept, so that it can still be inspected and
+// marshalled back to JSON.
+type UnknownMessageOrigin struct {
+	// Type is the type of the MessageOrigin, as sent by telegram.
+	Type MessageOriginType
+	// Raw contains the raw JSON data of the MessageOrigin.
+	Raw json.RawMessage
+}
+
+// Ensure that the unknown type correctly implements the parent interface.
+var _ MessageOrigin = UnknownMessageOrigin{}
+
+// GetType is a helper method to easily access the common fields of an interface.
+func (v UnknownMessageOrigin) GetType() MessageOriginType {
+	return v.Type
+}
+
+// GetDate is a helper method to easily access the common fields of an interface.
+func (v UnknownMessageOrigin) GetDate() int64 {
+	return v.MergeMessageOrigin().GetDate()
+}
+
+// MergeMessageOrigin returns a MergedMessageOrigin struct containing all the known fields of the MessageOrigin.
+// Fields which can't be decoded are left empty.
+func (v UnknownMessageOrigin) MergeMessageOrigin() MergedMessageOrigin {
+	m := MergedMessageOrigin{}
+	_ = json.Unmarshal(v.Raw, &m)
+	m.Type = v.Type
+	return m
+}
+
+// UnknownMessageOrigin.messageOrigin is a dummy method to avoid interface implementation.
+func (v UnknownMessageOrigin) messageOrigin() {}
+
+// MarshalJSON returns the raw JSON data of the MessageOrigin, so that it can be marshalled back without losing any fields.
+func (v UnknownMessageOrigin) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
 }
 
 // MessageOriginChannel (https://core.telegram.org/bots/api#messageoriginchannel)
@@ -6505,7 +6761,7 @@ type MessageOriginChannel struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MessageOriginChannel) GetType() string {
+func (v MessageOriginChannel) GetType() MessageOriginType {
 	return "channel"
 }
 
@@ -6554,7 +6810,7 @@ type MessageOriginChat struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MessageOriginChat) GetType() string {
+func (v MessageOriginChat) GetType() MessageOriginType {
 	return "chat"
 }
 
@@ -6600,7 +6856,7 @@ type MessageOriginHiddenUser struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MessageOriginHiddenUser) GetType() string {
+func (v MessageOriginHiddenUser) GetType() MessageOriginType {
 	return "hidden_user"
 }
 
@@ -6645,7 +6901,7 @@ type MessageOriginUser struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MessageOriginUser) GetType() string {
+func (v MessageOriginUser) GetType() MessageOriginType {
 	return "user"
 }
 
@@ -6769,7 +7025,7 @@ type OrderInfo struct {
 //   - PaidMediaPhoto
 //   - PaidMediaVideo
 type PaidMedia interface {
-	GetType() string
+	GetType() PaidMediaType
 	// MergePaidMedia returns a MergedPaidMedia struct to simplify working with complex telegram types in a non-generic world.
 	MergePaidMedia() MergedPaidMedia
 	// paidMedia exists to avoid external types implementing this interface.
@@ -6786,7 +7042,7 @@ var (
 // MergedPaidMedia is a helper type to simplify interactions with the various PaidMedia subtypes.
 type MergedPaidMedia struct {
 	// Type of the paid media
-	Type string `json:"type"`
+	Type PaidMediaType `json:"type"`
 	// Optional. Media width as defined by the sender (Only for preview)
 	Width int64 `json:"width,omitempty"`
 	// Optional. Media height as defined by the sender (Only for preview)
@@ -6800,7 +7056,7 @@ type MergedPaidMedia struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MergedPaidMedia) GetType() string {
+func (v MergedPaidMedia) GetType() PaidMediaType {
 	return v.Type
 }
 
@@ -6845,7 +7101,7 @@ func unmarshalPaidMedia(d json.RawMessage) (PaidMedia, error) {
 	}
 
 	t := struct {
-		Type string
+		Type PaidMediaType
 	}{}
 	err := json.Unmarshal(d, &t)
 	if err != nil {
@@ -6878,7 +7134,48 @@ func unmarshalPaidMedia(d json.RawMessage) (PaidMedia, error) {
 		return s, nil
 
 	}
-	return nil, fmt.Errorf("unknown interface for PaidMedia with Type %v", t.Type)
+	return UnknownPaidMedia{
+		Type: t.Type,
+		Raw:  d,
+	}, nil
+}
+
+// UnknownPaidMedia is a PaidMedia with a Type which isn't known to this version of the library; for
+// example, when telegram adds a new PaidMedia type. The raw JSON data is kept, so that it can still be inspected and
+// marshalled back to JSON.
+type UnknownPaidMedia struct {
+	// Type is the type of the PaidMedia, as sent by telegram.
+	Type PaidMediaType
+	// Raw contains the raw JSON data of the PaidMedia.
+	Raw json.RawMessage
+}
+
+// Ensure that the unknown type correctly implements the parent interface.
+var _ PaidMedia = UnknownPaidMedia{}
+
+// GetType is a helper method to easily access the common fields of an interface.
+func (v UnknownPaidMedia) GetType() PaidMediaType {
+	return v.Type
+}
+
+// MergePaidMedia returns a MergedPaidMedia struct containing all the known fields of the PaidMedia.
+// Fields which can't be decoded are left empty.
+func (v UnknownPaidMedia) MergePaidMedia() MergedPaidMedia {
+	m := MergedPaidMedia{}
+	_ = json.Unmarshal(v.Raw, &m)
+	m.Type = v.Type
+	return m
+}
+
+// UnknownPaidMedia.paidMedia is a dummy method to avoid interface implementation.
+func (v UnknownPaidMedia) paidMedia() {}
+
+// MarshalJSON returns the raw JSON data of the PaidMedia, so that it can be marshalled back without losing any fields.
+func (v UnknownPaidMedia) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
 }
 
 // PaidMediaInfo (https://core.telegram.org/bots/api#paidmediainfo)
@@ -6922,7 +7219,7 @@ type PaidMediaPhoto struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v PaidMediaPhoto) GetType() string {
+func (v PaidMediaPhoto) GetType() PaidMediaType {
 	return "photo"
 }
 
@@ -6963,7 +7260,7 @@ type PaidMediaPreview struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v PaidMediaPreview) GetType() string {
+func (v PaidMediaPreview) GetType() PaidMediaType {
 	return "preview"
 }
 
@@ -7012,7 +7309,7 @@ type PaidMediaVideo struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v PaidMediaVideo) GetType() string {
+func (v PaidMediaVideo) GetType() PaidMediaType {
 	return "video"
 }
 
@@ -7063,8 +7360,8 @@ type PassportData struct {
 //   - PassportElementErrorTranslationFiles
 //   - PassportElementErrorUnspecified
 type PassportElementError interface {
-	GetSource() string
-	GetType() string
+	GetSource() PassportElementErrorSource
+	GetType() EncryptedPassportElementType
 	GetMessage() string
 	// MergePassportElementError returns a MergedPassportElementError struct to simplify working with complex telegram types in a non-generic world.
 	MergePassportElementError() MergedPassportElementError
@@ -7088,9 +7385,9 @@ var (
 // MergedPassportElementError is a helper type to simplify interactions with the various PassportElementError subtypes.
 type MergedPassportElementError struct {
 	// Error source
-	Source string `json:"source"`
+	Source PassportElementErrorSource `json:"source"`
 	// The section of the user's Telegram Passport which has the error, one of "personal_details", "passport", "driver_license", "identity_card", "internal_passport", "address"
-	Type string `json:"type"`
+	Type EncryptedPassportElementType `json:"type"`
 	// Optional. Name of the data field which has the error (Only for data)
 	FieldName string `json:"field_name,omitempty"`
 	// Optional. Base64-encoded data hash (Only for data)
@@ -7106,12 +7403,12 @@ type MergedPassportElementError struct {
 }
 
 // GetSource is a helper method to easily access the common fields of an interface.
-func (v MergedPassportElementError) GetSource() string {
+func (v MergedPassportElementError) GetSource() PassportElementErrorSource {
 	return v.Source
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MergedPassportElementError) GetType() string {
+func (v MergedPassportElementError) GetType() EncryptedPassportElementType {
 	return v.Type
 }
 
@@ -7133,7 +7430,7 @@ func (v MergedPassportElementError) MergePassportElementError() MergedPassportEl
 // Represents an issue in one of the data fields that was provided by the user. The error is considered resolved when the field's value changes.
 type PassportElementErrorDataField struct {
 	// The section of the user's Telegram Passport which has the error, one of "personal_details", "passport", "driver_license", "identity_card", "internal_passport", "address"
-	Type string `json:"type"`
+	Type EncryptedPassportElementType `json:"type"`
 	// Name of the data field which has the error
 	FieldName string `json:"field_name"`
 	// Base64-encoded data hash
@@ -7143,12 +7440,12 @@ type PassportElementErrorDataField struct {
 }
 
 // GetSource is a helper method to easily access the common fields of an interface.
-func (v PassportElementErrorDataField) GetSource() string {
+func (v PassportElementErrorDataField) GetSource() PassportElementErrorSource {
 	return "data"
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v PassportElementErrorDataField) GetType() string {
+func (v PassportElementErrorDataField) GetType() EncryptedPassportElementType {
 	return v.Type
 }
 
@@ -7189,7 +7486,7 @@ func (v PassportElementErrorDataField) passportElementError() {}
 // Represents an issue with a document scan. The error is considered resolved when the file with the document scan changes.
 type PassportElementErrorFile struct {
 	// The section of the user's Telegram Passport which has the issue, one of "utility_bill", "bank_statement", "rental_agreement", "passport_registration", "temporary_registration"
-	Type string `json:"type"`
+	Type EncryptedPassportElementType `json:"type"`
 	// Base64-encoded file hash
 	FileHash string `json:"file_hash"`
 	// Error message
@@ -7197,12 +7494,12 @@ type PassportElementErrorFile struct {
 }
 
 // GetSource is a helper method to easily access the common fields of an interface.
-func (v PassportElementErrorFile) GetSource() string {
+func (v PassportElementErrorFile) GetSource() PassportElementErrorSource {
 	return "file"
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v PassportElementErrorFile) GetType() string {
+func (v PassportElementErrorFile) GetType() EncryptedPassportElementType {
 	return v.Type
 }
 
@@ -7242,7 +7539,7 @@ func (v PassportElementErrorFile) passportElementError() {}
 // Represents an issue with a list of scans. The error is considered resolved when the list of files containing the scans changes.
 type PassportElementErrorFiles struct {
 	// The section of the user's Telegram Passport which has the issue, one of "utility_bill", "bank_statement", "rental_agreement", "passport_registration", "temporary_registration"
-	Type string `json:"type"`
+	Type EncryptedPassportElementType `json:"type"`
 	// List of base64-encoded file hashes
 	FileHashes []string `json:"file_hashes,omitempty"`
 	// Error message
@@ -7250,12 +7547,12 @@ type PassportElementErrorFiles struct {
 }
 
 // GetSource is a helper method to easily access the common fields of an interface.
-func (v PassportElementErrorFiles) GetSource() string {
+func (v PassportElementErrorFiles) GetSource() PassportElementErrorSource {
 	return "files"
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v PassportElementErrorFiles) GetType() string {
+func (v PassportElementErrorFiles) GetType() EncryptedPassportElementType {
 	return v.Type
 }
 
@@ -7295,7 +7592,7 @@ func (v PassportElementErrorFiles) passportElementError() {}
 // Represents an issue with the front side of a document. The error is considered resolved when the file with the front side of the document changes.
 type PassportElementErrorFrontSide struct {
 	// The section of the user's Telegram Passport which has the issue, one of "passport", "driver_license", "identity_card", "internal_passport"
-	Type string `json:"type"`
+	Type EncryptedPassportElementType `json:"type"`
 	// Base64-encoded hash of the file with the front side of the document
 	FileHash string `json:"file_hash"`
 	// Error message
@@ -7303,12 +7600,12 @@ type PassportElementErrorFrontSide struct {
 }
 
 // GetSource is a helper method to easily access the common fields of an interface.
-func (v PassportElementErrorFrontSide) GetSource() string {
+func (v PassportElementErrorFrontSide) GetSource() PassportElementErrorSource {
 	return "front_side"
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v PassportElementErrorFrontSide) GetType() string {
+func (v PassportElementErrorFrontSide) GetType() EncryptedPassportElementType {
 	return v.Type
 }
 
@@ -7348,7 +7645,7 @@ func (v PassportElementErrorFrontSide) passportElementError() {}
 // Represents an issue with the reverse side of a document. The error is considered resolved when the file with reverse side of the document changes.
 type PassportElementErrorReverseSide struct {
 	// The section of the user's Telegram Passport which has the issue, one of "driver_license", "identity_card"
-	Type string `json:"type"`
+	Type EncryptedPassportElementType `json:"type"`
 	// Base64-encoded hash of the file with the reverse side of the document
 	FileHash string `json:"file_hash"`
 	// Error message
@@ -7356,12 +7653,12 @@ type PassportElementErrorReverseSide struct {
 }
 
 // GetSource is a helper method to easily access the common fields of an interface.
-func (v PassportElementErrorReverseSide) GetSource() string {
+func (v PassportElementErrorReverseSide) GetSource() PassportElementErrorSource {
 	return "reverse_side"
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v PassportElementErrorReverseSide) GetType() string {
+func (v PassportElementErrorReverseSide) GetType() EncryptedPassportElementType {
 	return v.Type
 }
 
@@ -7401,7 +7698,7 @@ func (v PassportElementErrorReverseSide) passportElementError() {}
 // Represents an issue with the selfie with a document. The error is considered resolved when the file with the selfie changes.
 type PassportElementErrorSelfie struct {
 	// The section of the user's Telegram Passport which has the issue, one of "passport", "driver_license", "identity_card", "internal_passport"
-	Type string `json:"type"`
+	Type EncryptedPassportElementType `json:"type"`
 	// Base64-encoded hash of the file with the selfie
 	FileHash string `json:"file_hash"`
 	// Error message
@@ -7409,12 +7706,12 @@ type PassportElementErrorSelfie struct {
 }
 
 // GetSource is a helper method to easily access the common fields of an interface.
-func (v PassportElementErrorSelfie) GetSource() string {
+func (v PassportElementErrorSelfie) GetSource() PassportElementErrorSource {
 	return "selfie"
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v PassportElementErrorSelfie) GetType() string {
+func (v PassportElementErrorSelfie) GetType() EncryptedPassportElementType {
 	return v.Type
 }
 
@@ -7454,7 +7751,7 @@ func (v PassportElementErrorSelfie) passportElementError() {}
 // Represents an issue with one of the files that constitute the translation of a document. The error is considered resolved when the file changes.
 type PassportElementErrorTranslationFile struct {
 	// Type of element of the user's Telegram Passport which has the issue, one of "passport", "driver_license", "identity_card", "internal_passport", "utility_bill", "bank_statement", "rental_agreement", "passport_registration", "temporary_registration"
-	Type string `json:"type"`
+	Type EncryptedPassportElementType `json:"type"`
 	// Base64-encoded file hash
 	FileHash string `json:"file_hash"`
 	// Error message
@@ -7462,12 +7759,12 @@ type PassportElementErrorTranslationFile struct {
 }
 
 // GetSource is a helper method to easily access the common fields of an interface.
-func (v PassportElementErrorTranslationFile) GetSource() string {
+func (v PassportElementErrorTranslationFile) GetSource() PassportElementErrorSource {
 	return "translation_file"
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v PassportElementErrorTranslationFile) GetType() string {
+func (v PassportElementErrorTranslationFile) GetType() EncryptedPassportElementType {
 	return v.Type
 }
 
@@ -7507,7 +7804,7 @@ func (v PassportElementErrorTranslationFile) passportElementError() {}
 // Represents an issue with the translated version of a document. The error is considered resolved when a file with the document translation change.
 type PassportElementErrorTranslationFiles struct {
 	// Type of element of the user's Telegram Passport which has the issue, one of "passport", "driver_license", "identity_card", "internal_passport", "utility_bill", "bank_statement", "rental_agreement", "passport_registration", "temporary_registration"
-	Type string `json:"type"`
+	Type EncryptedPassportElementType `json:"type"`
 	// List of base64-encoded file hashes
 	FileHashes []string `json:"file_hashes,omitempty"`
 	// Error message
@@ -7515,12 +7812,12 @@ type PassportElementErrorTranslationFiles struct {
 }
 
 // GetSource is a helper method to easily access the common fields of an interface.
-func (v PassportElementErrorTranslationFiles) GetSource() string {
+func (v PassportElementErrorTranslationFiles) GetSource() PassportElementErrorSource {
 	return "translation_files"
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v PassportElementErrorTranslationFiles) GetType() string {
+func (v PassportElementErrorTranslationFiles) GetType() EncryptedPassportElementType {
 	return v.Type
 }
 
@@ -7560,7 +7857,7 @@ func (v PassportElementErrorTranslationFiles) passportElementError() {}
 // Represents an issue in an unspecified place. The error is considered resolved when new data is added.
 type PassportElementErrorUnspecified struct {
 	// Type of element of the user's Telegram Passport which has the issue
-	Type string `json:"type"`
+	Type EncryptedPassportElementType `json:"type"`
 	// Base64-encoded element hash
 	ElementHash string `json:"element_hash"`
 	// Error message
@@ -7568,12 +7865,12 @@ type PassportElementErrorUnspecified struct {
 }
 
 // GetSource is a helper method to easily access the common fields of an interface.
-func (v PassportElementErrorUnspecified) GetSource() string {
+func (v PassportElementErrorUnspecified) GetSource() PassportElementErrorSource {
 	return "unspecified"
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v PassportElementErrorUnspecified) GetType() string {
+func (v PassportElementErrorUnspecified) GetType() EncryptedPassportElementType {
 	return v.Type
 }
 
@@ -7657,7 +7954,7 @@ type Poll struct {
 	// True, if the poll is anonymous
 	IsAnonymous bool `json:"is_anonymous"`
 	// Poll type, currently can be "regular" or "quiz"
-	Type string `json:"type"`
+	Type PollType `json:"type"`
 	// True, if the poll allows multiple answers
 	AllowsMultipleAnswers bool `json:"allows_multiple_answers"`
 	// Optional. 0-based identifier of the correct answer option. Available only for polls in the quiz mode, which are closed, or was sent (not forwarded) by the bot or to the private chat with the bot.
@@ -7779,7 +8076,7 @@ func (v *ReactionCount) UnmarshalJSON(b []byte) error {
 //   - ReactionTypeCustomEmoji
 //   - ReactionTypePaid
 type ReactionType interface {
-	GetType() string
+	GetType() ReactionTypeType
 	// MergeReactionType returns a MergedReactionType struct to simplify working with complex telegram types in a non-generic world.
 	MergeReactionType() MergedReactionType
 	// reactionType exists to avoid external types implementing this interface.
@@ -7796,7 +8093,7 @@ var (
 // MergedReactionType is a helper type to simplify interactions with the various ReactionType subtypes.
 type MergedReactionType struct {
 	// Type of the reaction
-	Type string `json:"type"`
+	Type ReactionTypeType `json:"type"`
 	// Optional. Reaction emoji. Currently, it can be one of "👍", "👎", "❤", "🔥", "🥰", "👏", "😁", "🤔", "🤯", "😱", "🤬", "😢", "🎉", "🤩", "🤮", "💩", "🙏", "👌", "🕊", "🤡", "🥱", "🥴", "😍", "🐳", "❤‍🔥", "🌚", "🌭", "💯", "🤣", "⚡", "🍌", "🏆", "💔", "🤨", "😐", "🍓", "🍾", "💋", "🖕", "😈", "😴", "😭", "🤓", "👻", "👨‍💻", "👀", "🎃", "🙈", "😇", "😨", "🤝", "✍", "🤗", "🫡", "🎅", "🎄", "☃", "💅", "🤪", "🗿", "🆒", "💘", "🙉", "🦄", "😘", "💊", "🙊", "😎", "👾", "🤷‍♂", "🤷", "🤷‍♀", "😡" (Only for emoji)
 	Emoji string `json:"emoji,omitempty"`
 	// Optional. Custom emoji identifier (Only for custom_emoji)
@@ -7804,7 +8101,7 @@ type MergedReactionType struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MergedReactionType) GetType() string {
+func (v MergedReactionType) GetType() ReactionTypeType {
 	return v.Type
 }
 
@@ -7849,7 +8146,7 @@ func unmarshalReactionType(d json.RawMessage) (ReactionType, error) {
 	}
 
 	t := struct {
-		Type string
+		Type ReactionTypeType
 	}{}
 	err := json.Unmarshal(d, &t)
 	if err != nil {
@@ -7882,7 +8179,48 @@ func unmarshalReactionType(d json.RawMessage) (ReactionType, error) {
 		return s, nil
 
 	}
-	return nil, fmt.Errorf("unknown interface for ReactionType with Type %v", t.Type)
+	return UnknownReactionType{
+		Type: t.Type,
+		Raw:  d,
+	}, nil
+}
+
+// UnknownReactionType is a ReactionType with a Type which isn't known to this version of the library; for
+// example, when telegram adds a new ReactionType type. The raw JSON data is kept, so that it can still be inspected and
+// marshalled back to JSON.
+type UnknownReactionType struct {
+	// Type is the type of the ReactionType, as sent by telegram.
+	Type ReactionTypeType
+	// Raw contains the raw JSON data of the ReactionType.
+	Raw json.RawMessage
+}
+
+// Ensure that the unknown type correctly implements the parent interface.
+var _ ReactionType = UnknownReactionType{}
+
+// GetType is a helper method to easily access the common fields of an interface.
+func (v UnknownReactionType) GetType() ReactionTypeType {
+	return v.Type
+}
+
+// MergeReactionType returns a MergedReactionType struct containing all the known fields of the ReactionType.
+// Fields which can't be decoded are left empty.
+func (v UnknownReactionType) MergeReactionType() MergedReactionType {
+	m := MergedReactionType{}
+	_ = json.Unmarshal(v.Raw, &m)
+	m.Type = v.Type
+	return m
+}
+
+// UnknownReactionType.reactionType is a dummy method to avoid interface implementation.
+func (v UnknownReactionType) reactionType() {}
+
+// MarshalJSON returns the raw JSON data of the ReactionType, so that it can be marshalled back without losing any fields.
+func (v UnknownReactionType) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
 }
 
 // ReactionTypeCustomEmoji (https://core.telegram.org/bots/api#reactiontypecustomemoji)
@@ -7894,7 +8232,7 @@ type ReactionTypeCustomEmoji struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v ReactionTypeCustomEmoji) GetType() string {
+func (v ReactionTypeCustomEmoji) GetType() ReactionTypeType {
 	return "custom_emoji"
 }
 
@@ -7931,7 +8269,7 @@ type ReactionTypeEmoji struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v ReactionTypeEmoji) GetType() string {
+func (v ReactionTypeEmoji) GetType() ReactionTypeType {
 	return "emoji"
 }
 
@@ -7965,7 +8303,7 @@ func (v ReactionTypeEmoji) reactionType() {}
 type ReactionTypePaid struct{}
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v ReactionTypePaid) GetType() string {
+func (v ReactionTypePaid) GetType() ReactionTypeType {
 	return "paid"
 }
 
@@ -8079,7 +8417,7 @@ type ResponseParameters struct {
 //   - RevenueWithdrawalStateSucceeded
 //   - RevenueWithdrawalStateFailed
 type RevenueWithdrawalState interface {
-	GetType() string
+	GetType() RevenueWithdrawalStateType
 	// MergeRevenueWithdrawalState returns a MergedRevenueWithdrawalState struct to simplify working with complex telegram types in a non-generic world.
 	MergeRevenueWithdrawalState() MergedRevenueWithdrawalState
 	// revenueWithdrawalState exists to avoid external types implementing this interface.
@@ -8096,7 +8434,7 @@ var (
 // MergedRevenueWithdrawalState is a helper type to simplify interactions with the various RevenueWithdrawalState subtypes.
 type MergedRevenueWithdrawalState struct {
 	// Type of the state
-	Type string `json:"type"`
+	Type RevenueWithdrawalStateType `json:"type"`
 	// Optional. Date the withdrawal was completed in Unix time (Only for succeeded)
 	Date int64 `json:"date,omitempty"`
 	// Optional. An HTTPS URL that can be used to see transaction details (Only for succeeded)
@@ -8104,7 +8442,7 @@ type MergedRevenueWithdrawalState struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MergedRevenueWithdrawalState) GetType() string {
+func (v MergedRevenueWithdrawalState) GetType() RevenueWithdrawalStateType {
 	return v.Type
 }
 
@@ -8149,7 +8487,7 @@ func unmarshalRevenueWithdrawalState(d json.RawMessage) (RevenueWithdrawalState,
 	}
 
 	t := struct {
-		Type string
+		Type RevenueWithdrawalStateType
 	}{}
 	err := json.Unmarshal(d, &t)
 	if err != nil {
@@ -8182,7 +8520,48 @@ func unmarshalRevenueWithdrawalState(d json.RawMessage) (RevenueWithdrawalState,
 		return s, nil
 
 	}
-	return nil, fmt.Errorf("unknown interface for RevenueWithdrawalState with Type %v", t.Type)
+	return UnknownRevenueWithdrawalState{
+		Type: t.Type,
+		Raw:  d,
+	}, nil
+}
+
+// UnknownRevenueWithdrawalState is a RevenueWithdrawalState with a Type which isn't known to this version of the library; for
+// example, when telegram adds a new RevenueWithdrawalState type. The raw JSON data is kept, so that it can still be inspected and
+// marshalled back to JSON.
+type UnknownRevenueWithdrawalState struct {
+	// Type is the type of the RevenueWithdrawalState, as sent by telegram.
+	Type RevenueWithdrawalStateType
+	// Raw contains the raw JSON data of the RevenueWithdrawalState.
+	Raw json.RawMessage
+}
+
+// Ensure that the unknown type correctly implements the parent interface.
+var _ RevenueWithdrawalState = UnknownRevenueWithdrawalState{}
+
+// GetType is a helper method to easily access the common fields of an interface.
+func (v UnknownRevenueWithdrawalState) GetType() RevenueWithdrawalStateType {
+	return v.Type
+}
+
+// MergeRevenueWithdrawalState returns a MergedRevenueWithdrawalState struct containing all the known fields of the RevenueWithdrawalState.
+// Fields which can't be decoded are left empty.
+func (v UnknownRevenueWithdrawalState) MergeRevenueWithdrawalState() MergedRevenueWithdrawalState {
+	m := MergedRevenueWithdrawalState{}
+	_ = json.Unmarshal(v.Raw, &m)
+	m.Type = v.Type
+	return m
+}
+
+// UnknownRevenueWithdrawalState.revenueWithdrawalState is a dummy method to avoid interface implementation.
+func (v UnknownRevenueWithdrawalState) revenueWithdrawalState() {}
+
+// MarshalJSON returns the raw JSON data of the RevenueWithdrawalState, so that it can be marshalled back without losing any fields.
+func (v UnknownRevenueWithdrawalState) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
 }
 
 // RevenueWithdrawalStateFailed (https://core.telegram.org/bots/api#revenuewithdrawalstatefailed)
@@ -8191,7 +8570,7 @@ func unmarshalRevenueWithdrawalState(d json.RawMessage) (RevenueWithdrawalState,
 type RevenueWithdrawalStateFailed struct{}
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v RevenueWithdrawalStateFailed) GetType() string {
+func (v RevenueWithdrawalStateFailed) GetType() RevenueWithdrawalStateType {
 	return "failed"
 }
 
@@ -8224,7 +8603,7 @@ func (v RevenueWithdrawalStateFailed) revenueWithdrawalState() {}
 type RevenueWithdrawalStatePending struct{}
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v RevenueWithdrawalStatePending) GetType() string {
+func (v RevenueWithdrawalStatePending) GetType() RevenueWithdrawalStateType {
 	return "pending"
 }
 
@@ -8262,7 +8641,7 @@ type RevenueWithdrawalStateSucceeded struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v RevenueWithdrawalStateSucceeded) GetType() string {
+func (v RevenueWithdrawalStateSucceeded) GetType() RevenueWithdrawalStateType {
 	return "succeeded"
 }
 
@@ -8423,7 +8802,7 @@ type Sticker struct {
 	// Unique identifier for this file, which is supposed to be the same over time and for different bots. Can't be used to download or reuse the file.
 	FileUniqueId string `json:"file_unique_id"`
 	// Type of the sticker, currently one of "regular", "mask", "custom_emoji". The type of the sticker is independent from its format, which is determined by the fields is_animated and is_video.
-	Type string `json:"type"`
+	Type StickerType `json:"type"`
 	// Sticker width
 	Width int64 `json:"width"`
 	// Sticker height
@@ -8459,7 +8838,7 @@ type StickerSet struct {
 	// Sticker set title
 	Title string `json:"title"`
 	// Type of stickers in the set, currently one of "regular", "mask", "custom_emoji"
-	StickerType string `json:"sticker_type"`
+	StickerType StickerType `json:"sticker_type"`
 	// List of all set stickers
 	Stickers []Sticker `json:"stickers,omitempty"`
 	// Optional. Sticker set thumbnail in the .WEBP, .TGS, or .WEBM format
@@ -8541,7 +8920,7 @@ type TextQuote struct {
 //   - TransactionPartnerTelegramApi
 //   - TransactionPartnerOther
 type TransactionPartner interface {
-	GetType() string
+	GetType() TransactionPartnerType
 	// MergeTransactionPartner returns a MergedTransactionPartner struct to simplify working with complex telegram types in a non-generic world.
 	MergeTransactionPartner() MergedTransactionPartner
 	// transactionPartner exists to avoid external types implementing this interface.
@@ -8560,7 +8939,7 @@ var (
 // MergedTransactionPartner is a helper type to simplify interactions with the various TransactionPartner subtypes.
 type MergedTransactionPartner struct {
 	// Type of the transaction partner
-	Type string `json:"type"`
+	Type TransactionPartnerType `json:"type"`
 	// Optional. Information about the user (Only for user)
 	User *User `json:"user,omitempty"`
 	// Optional. Bot-specified invoice payload (Only for user)
@@ -8580,7 +8959,7 @@ type MergedTransactionPartner struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v MergedTransactionPartner) GetType() string {
+func (v MergedTransactionPartner) GetType() TransactionPartnerType {
 	return v.Type
 }
 
@@ -8625,7 +9004,7 @@ func unmarshalTransactionPartner(d json.RawMessage) (TransactionPartner, error)
 	}
 
 	t := struct {
-		Type string
+		Type TransactionPartnerType
 	}{}
 	err := json.Unmarshal(d, &t)
 	if err != nil {
@@ -8674,7 +9053,48 @@ func unmarshalTransactionPartner(d json.RawMessage) (TransactionPartner, error)
 		return s, nil
 
 	}
-	return nil, fmt.Errorf("unknown interface for TransactionPartner with Type %v", t.Type)
+	return UnknownTransactionPartner{
+		Type: t.Type,
+		Raw:  d,
+	}, nil
+}
+
+// UnknownTransactionPartner is a TransactionPartner with a Type which isn't known to this version of the library; for
+// example, when telegram adds a new TransactionPartner type. The raw JSON data is kept, so that it can still be inspected and
+// marshalled back to JSON.
+type UnknownTransactionPartner struct {
+	// Type is the type of the TransactionPartner, as sent by telegram.
+	Type TransactionPartnerType
+	// Raw contains the raw JSON data of the TransactionPartner.
+	Raw json.RawMessage
+}
+
+// Ensure that the unknown type correctly implements the parent interface.
+var _ TransactionPartner = UnknownTransactionPartner{}
+
+// GetType is a helper method to easily access the common fields of an interface.
+func (v UnknownTransactionPartner) GetType() TransactionPartnerType {
+	return v.Type
+}
+
+// MergeTransactionPartner returns a MergedTransactionPartner struct containing all the known fields of the TransactionPartner.
+// Fields which can't be decoded are left empty.
+func (v UnknownTransactionPartner) MergeTransactionPartner() MergedTransactionPartner {
+	m := MergedTransactionPartner{}
+	_ = json.Unmarshal(v.Raw, &m)
+	m.Type = v.Type
+	return m
+}
+
+// UnknownTransactionPartner.transactionPartner is a dummy method to avoid interface implementation.
+func (v UnknownTransactionPartner) transactionPartner() {}
+
+// MarshalJSON returns the raw JSON data of the TransactionPartner, so that it can be marshalled back without losing any fields.
+func (v UnknownTransactionPartner) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
 }
 
 // TransactionPartnerFragment (https://core.telegram.org/bots/api#transactionpartnerfragment)
@@ -8706,7 +9126,7 @@ func (v *TransactionPartnerFragment) UnmarshalJSON(b []byte) error {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v TransactionPartnerFragment) GetType() string {
+func (v TransactionPartnerFragment) GetType() TransactionPartnerType {
 	return "fragment"
 }
 
@@ -8740,7 +9160,7 @@ func (v TransactionPartnerFragment) transactionPartner() {}
 type TransactionPartnerOther struct{}
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v TransactionPartnerOther) GetType() string {
+func (v TransactionPartnerOther) GetType() TransactionPartnerType {
 	return "other"
 }
 
@@ -8773,7 +9193,7 @@ func (v TransactionPartnerOther) transactionPartner() {}
 type TransactionPartnerTelegramAds struct{}
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v TransactionPartnerTelegramAds) GetType() string {
+func (v TransactionPartnerTelegramAds) GetType() TransactionPartnerType {
 	return "telegram_ads"
 }
 
@@ -8809,7 +9229,7 @@ type TransactionPartnerTelegramApi struct {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v TransactionPartnerTelegramApi) GetType() string {
+func (v TransactionPartnerTelegramApi) GetType() TransactionPartnerType {
 	return "telegram_api"
 }
 
@@ -8886,7 +9306,7 @@ func (v *TransactionPartnerUser) UnmarshalJSON(b []byte) error {
 }
 
 // GetType is a helper method to easily access the common fields of an interface.
-func (v TransactionPartnerUser) GetType() string {
+func (v TransactionPartnerUser) GetType() TransactionPartnerType {
 	return "user"
 }
 
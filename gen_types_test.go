@@ -1,6 +1,7 @@
 package gotgbot
 
 import (
+	"encoding/json"
 	"testing"
 )
 
@@ -22,3 +23,44 @@ func TestInlineQueriesHavePointers(t *testing.T) {
 		SwitchInlineQueryCurrentChat: &stringValue, // or can be a pointer
 	}
 }
+
+func TestUnknownUnionTypes(t *testing.T) {
+	data := []byte(`{
+		"chat": {"id": 1, "type": "group"},
+		"from": {"id": 2, "is_bot": false, "first_name": "a"},
+		"date": 1,
+		"old_chat_member": {"status": "member", "user": {"id": 3, "is_bot": false, "first_name": "b"}},
+		"new_chat_member": {"status": "future_status", "user": {"id": 3, "is_bot": false, "first_name": "b"}, "new_field": true}
+	}`)
+
+	var u ChatMemberUpdated
+	if err := json.Unmarshal(data, &u); err != nil {
+		t.Fatalf("failed to unmarshal update with unknown chat member status: %v", err)
+	}
+
+	unknown, ok := u.NewChatMember.(UnknownChatMember)
+	if !ok {
+		t.Fatalf("expected an UnknownChatMember, got %T", u.NewChatMember)
+	}
+	if unknown.GetStatus() != "future_status" {
+		t.Errorf("expected the status to be kept, got %q", unknown.GetStatus())
+	}
+	if unknown.GetUser().Id != 3 {
+		t.Errorf("expected the known user field to be decoded, got %+v", unknown.GetUser())
+	}
+	if merged := unknown.MergeChatMember(); merged.Status != "future_status" || merged.User.FirstName != "b" {
+		t.Errorf("unexpected merged chat member: %+v", merged)
+	}
+
+	out, err := json.Marshal(u.NewChatMember)
+	if err != nil {
+		t.Fatalf("failed to marshal unknown chat member: %v", err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(out, &fields); err != nil {
+		t.Fatalf("failed to unmarshal marshalled chat member: %v", err)
+	}
+	if fields["new_field"] != true || fields["status"] != "future_status" {
+		t.Errorf("expected the raw JSON to be kept when marshalling, got %s", out)
+	}
+}
@@ -0,0 +1,275 @@
+package gotgbottest
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+)
+
+var (
+	ErrUnexpectedCall = errors.New("unexpected call")
+	ErrRecordedError  = errors.New("recorded error")
+	ErrNoFileData     = errors.New("file has no data")
+)
+
+// Interaction is a single recorded request to the Bot API, along with its response. Cassettes are stored as JSONL;
+// one Interaction per line.
+type Interaction struct {
+	// Method is the name of the API method which was called.
+	Method string `json:"method"`
+	// Params contains all the non-file parameters of the call.
+	Params map[string]string `json:"params,omitempty"`
+	// Files contains the name and size of all the files uploaded as part of the call, by field name.
+	Files map[string]RecordedFile `json:"files,omitempty"`
+	// Result is the result returned by the API, if the call succeeded.
+	Result json.RawMessage `json:"result,omitempty"`
+	// Error is the error returned by the API, if the call failed.
+	Error *RecordedError `json:"error,omitempty"`
+}
+
+// RecordedFile describes a file uploaded as part of a recorded call. The contents are not stored.
+type RecordedFile struct {
+	// Name is the name of the uploaded file.
+	Name string `json:"name"`
+	// Size is the size of the uploaded file, in bytes.
+	Size int64 `json:"size"`
+}
+
+// RecordedError is an error returned by a recorded call.
+type RecordedError struct {
+	// Code is the telegram error code; 0 if this was not a telegram error (eg, a network error).
+	Code int `json:"code,omitempty"`
+	// Description describes the error.
+	Description string `json:"description"`
+	// Parameters contains any extra data returned along with a telegram error.
+	Parameters *gotgbot.ResponseParameters `json:"parameters,omitempty"`
+}
+
+// RecordingBotClient wraps a BotClient, and records every request and response to a cassette.
+type RecordingBotClient struct {
+	// The underlying BotClient used to make the requests.
+	gotgbot.BotClient
+
+	// w is where the cassette is written to.
+	w io.Writer
+	// mux ensures interactions are written one at a time.
+	mux sync.Mutex
+}
+
+// Ensure compile-time type safety.
+var (
+	_ gotgbot.BotClient = &RecordingBotClient{}
+	_ gotgbot.BotClient = &ReplayBotClient{}
+)
+
+// NewRecordingBotClient creates a RecordingBotClient, which writes the cassette to w.
+// If client is nil, a gotgbot.BaseBotClient is used.
+func NewRecordingBotClient(client gotgbot.BotClient, w io.Writer) *RecordingBotClient {
+	if client == nil {
+		client = &gotgbot.BaseBotClient{}
+	}
+	return &RecordingBotClient{
+		BotClient: client,
+		w:         w,
+	}
+}
+
+func (c *RecordingBotClient) RequestWithContext(ctx context.Context, token string, method string, params map[string]string, data map[string]gotgbot.FileReader, opts *gotgbot.RequestOpts) (json.RawMessage, error) {
+	files, data, err := recordFiles(data)
+	if err != nil {
+		return nil, err
+	}
+
+	r, reqErr := c.BotClient.RequestWithContext(ctx, token, method, params, data, opts)
+
+	interaction := Interaction{
+		Method: method,
+		Params: params,
+		Files:  files,
+		Result: r,
+	}
+	if reqErr != nil {
+		// Network errors include the request URL, which contains the bot token; cassettes are meant to be committed,
+		// so it must never be written to them.
+		interaction.Error = &RecordedError{Description: redactToken(reqErr.Error(), token)}
+
+		var tgErr *gotgbot.TelegramError
+		if errors.As(reqErr, &tgErr) {
+			interaction.Error = &RecordedError{
+				Code:        tgErr.Code,
+				Description: tgErr.Description,
+				Parameters:  tgErr.ResponseParams,
+			}
+		}
+	}
+
+	bs, err := json.Marshal(interaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode interaction: %w", err)
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if _, err := c.w.Write(append(bs, '\n')); err != nil {
+		return nil, fmt.Errorf("failed to write interaction to cassette: %w", err)
+	}
+
+	return r, reqErr
+}
+
+// redactToken replaces any occurrences of the bot token in s.
+func redactToken(s string, token string) string {
+	if token == "" {
+		return s
+	}
+	return strings.ReplaceAll(s, token, "<TOKEN>")
+}
+
+// recordFiles gets the name and size of every file. Since getting the size requires reading the file, the contents are
+// buffered, and new FileReaders are returned.
+func recordFiles(data map[string]gotgbot.FileReader) (map[string]RecordedFile, map[string]gotgbot.FileReader, error) {
+	if len(data) == 0 {
+		return nil, data, nil
+	}
+
+	files := make(map[string]RecordedFile, len(data))
+	buffered := make(map[string]gotgbot.FileReader, len(data))
+	for field, f := range data {
+		if f.Data == nil {
+			return nil, nil, fmt.Errorf("failed to read file %s: %w", field, ErrNoFileData)
+		}
+
+		bs, err := io.ReadAll(f.Data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read file %s: %w", field, err)
+		}
+
+		files[field] = RecordedFile{Name: f.Name, Size: int64(len(bs))}
+		buffered[field] = gotgbot.FileReader{Name: f.Name, Data: bytes.NewReader(bs)}
+	}
+	return files, buffered, nil
+}
+
+// ReplayBotClient is a BotClient which serves the responses of a recorded cassette, without making any requests.
+// Calls are expected to happen in the same order, with the same parameters, as when they were recorded; any other
+// call fails with ErrUnexpectedCall.
+type ReplayBotClient struct {
+	// IgnoredParams contains the names of the parameters which are not compared when matching calls; for example,
+	// parameters which contain timestamps.
+	IgnoredParams []string
+
+	// interactions contains all the recorded interactions, in order.
+	interactions []Interaction
+	// next is the index of the next expected interaction.
+	next int
+	// mux ensures interactions are replayed one at a time.
+	mux sync.Mutex
+}
+
+// NewReplayBotClient creates a ReplayBotClient from the cassette in r.
+func NewReplayBotClient(r io.Reader) (*ReplayBotClient, error) {
+	var interactions []Interaction
+
+	scanner := bufio.NewScanner(r)
+	// Results can contain large objects; allow for lines of up to 16MB.
+	scanner.Buffer(nil, 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var i Interaction
+		if err := json.Unmarshal(line, &i); err != nil {
+			return nil, fmt.Errorf("failed to decode interaction %d: %w", len(interactions)+1, err)
+		}
+		interactions = append(interactions, i)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+
+	return &ReplayBotClient{interactions: interactions}, nil
+}
+
+func (c *ReplayBotClient) RequestWithContext(ctx context.Context, token string, method string, params map[string]string, data map[string]gotgbot.FileReader, opts *gotgbot.RequestOpts) (json.RawMessage, error) {
+	files, _, err := recordFiles(data)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if c.next >= len(c.interactions) {
+		return nil, fmt.Errorf("%w: %s was called after all %d recorded calls were replayed", ErrUnexpectedCall, method, len(c.interactions))
+	}
+
+	expected := c.interactions[c.next]
+	if expected.Method != method {
+		return nil, fmt.Errorf("%w: call %d: expected %s, got %s", ErrUnexpectedCall, c.next+1, expected.Method, method)
+	}
+	if !reflect.DeepEqual(c.withoutIgnored(expected.Params), c.withoutIgnored(params)) {
+		return nil, fmt.Errorf("%w: call %d to %s: expected params %v, got %v", ErrUnexpectedCall, c.next+1, method, expected.Params, params)
+	}
+	if len(expected.Files) != 0 || len(files) != 0 {
+		if !reflect.DeepEqual(expected.Files, files) {
+			return nil, fmt.Errorf("%w: call %d to %s: expected files %v, got %v", ErrUnexpectedCall, c.next+1, method, expected.Files, files)
+		}
+	}
+	c.next++
+
+	if expected.Error != nil {
+		if expected.Error.Code == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrRecordedError, expected.Error.Description)
+		}
+		return nil, &gotgbot.TelegramError{
+			Method:         method,
+			Params:         params,
+			Code:           expected.Error.Code,
+			Description:    expected.Error.Description,
+			ResponseParams: expected.Error.Parameters,
+		}
+	}
+	return expected.Result, nil
+}
+
+// withoutIgnored returns a copy of the params, without the ignored params.
+func (c *ReplayBotClient) withoutIgnored(params map[string]string) map[string]string {
+	filtered := make(map[string]string, len(params))
+	for k, v := range params {
+		filtered[k] = v
+	}
+	for _, k := range c.IgnoredParams {
+		delete(filtered, k)
+	}
+	return filtered
+}
+
+// Remaining returns the number of recorded calls which have not been replayed yet.
+func (c *ReplayBotClient) Remaining() int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	return len(c.interactions) - c.next
+}
+
+func (c *ReplayBotClient) GetAPIURL(opts *gotgbot.RequestOpts) string {
+	if opts != nil && opts.APIURL != "" {
+		return opts.APIURL
+	}
+	return gotgbot.DefaultAPIURL
+}
+
+func (c *ReplayBotClient) FileURL(token string, tgFilePath string, opts *gotgbot.RequestOpts) string {
+	return fmt.Sprintf("%s/file/bot%s/%s", c.GetAPIURL(opts), token, tgFilePath)
+}
@@ -0,0 +1,138 @@
+package gotgbottest_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/PaulSonOfLars/gotgbot/v2/gotgbottest"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	s := gotgbottest.NewServer()
+	defer s.Close()
+
+	cassette := &bytes.Buffer{}
+	b := &gotgbot.Bot{
+		Token: gotgbottest.DefaultToken,
+		BotClient: gotgbottest.NewRecordingBotClient(&gotgbot.BaseBotClient{
+			DefaultRequestOpts: s.RequestOpts(),
+		}, cassette),
+	}
+
+	flow := func(b *gotgbot.Bot) error {
+		if _, err := b.SendMessage(1, "hello", nil); err != nil {
+			return err
+		}
+		if _, err := b.SendDocument(1, gotgbot.InputFileByReader("file.txt", strings.NewReader("contents")), nil); err != nil {
+			return err
+		}
+		_, err := b.SendMessage(2, "not found", nil)
+		return err
+	}
+
+	// The first message succeeds, and the last one fails.
+	s.Handle("sendMessage", func(call gotgbottest.Call) (interface{}, error) {
+		if call.Param("chat_id") == "2" {
+			return nil, &gotgbot.TelegramError{Code: http.StatusBadRequest, Description: "Bad Request: chat not found"}
+		}
+		return gotgbot.Message{MessageId: 5, Text: call.Param("text")}, nil
+	})
+
+	recordErr := flow(b)
+	var tgErr *gotgbot.TelegramError
+	if !errors.As(recordErr, &tgErr) || tgErr.Code != http.StatusBadRequest {
+		t.Fatalf("expected recorded flow to fail with a 400, got: %v", recordErr)
+	}
+
+	replay, err := gotgbottest.NewReplayBotClient(bytes.NewReader(cassette.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to load cassette: %v", err)
+	}
+	if replay.Remaining() != 3 {
+		t.Fatalf("expected 3 recorded calls, got %d", replay.Remaining())
+	}
+
+	replayErr := flow(&gotgbot.Bot{Token: gotgbottest.DefaultToken, BotClient: replay})
+	if !errors.As(replayErr, &tgErr) || tgErr.Code != http.StatusBadRequest || tgErr.Description != "Bad Request: chat not found" {
+		t.Fatalf("expected replayed flow to fail with the recorded error, got: %v", replayErr)
+	}
+	if replay.Remaining() != 0 {
+		t.Errorf("expected all calls to be replayed, %d remaining", replay.Remaining())
+	}
+}
+
+func TestReplayFailsOnUnexpectedCalls(t *testing.T) {
+	cassette := `{"method": "sendMessage", "params": {"chat_id": "1", "text": "hello"}, "result": {"message_id": 1, "date": 0, "chat": {"id": 1, "type": "private"}}}`
+
+	replay, err := gotgbottest.NewReplayBotClient(strings.NewReader(cassette))
+	if err != nil {
+		t.Fatalf("failed to load cassette: %v", err)
+	}
+	b := &gotgbot.Bot{Token: gotgbottest.DefaultToken, BotClient: replay}
+
+	if _, err := b.SendMessage(1, "goodbye", nil); !errors.Is(err, gotgbottest.ErrUnexpectedCall) {
+		t.Errorf("expected different params to fail, got: %v", err)
+	}
+
+	msg, err := b.SendMessage(1, "hello", nil)
+	if err != nil {
+		t.Fatalf("expected recorded call to be replayed, got: %v", err)
+	}
+	if msg.MessageId != 1 {
+		t.Errorf("unexpected replayed message: %+v", msg)
+	}
+
+	if _, err := b.SendMessage(1, "hello", nil); !errors.Is(err, gotgbottest.ErrUnexpectedCall) {
+		t.Errorf("expected extra calls to fail, got: %v", err)
+	}
+}
+
+func TestRecordFailsOnFilesWithoutData(t *testing.T) {
+	cassette := &bytes.Buffer{}
+	c := gotgbottest.NewRecordingBotClient(&gotgbot.BaseBotClient{}, cassette)
+
+	_, err := c.RequestWithContext(context.Background(), gotgbottest.DefaultToken, "sendDocument", map[string]string{"chat_id": "1"}, map[string]gotgbot.FileReader{
+		"document": {Name: "file.txt"},
+	}, nil)
+	if !errors.Is(err, gotgbottest.ErrNoFileData) {
+		t.Fatalf("expected a missing file data error, got: %v", err)
+	}
+	if cassette.Len() != 0 {
+		t.Errorf("expected nothing to be recorded, got %s", cassette.String())
+	}
+}
+
+func TestRecordRedactsToken(t *testing.T) {
+	s := gotgbottest.NewServer()
+	reqOpts := s.RequestOpts()
+	// Closing the server makes every request fail with a network error, which includes the request URL.
+	s.Close()
+
+	cassette := &bytes.Buffer{}
+	b := &gotgbot.Bot{
+		Token: gotgbottest.DefaultToken,
+		BotClient: gotgbottest.NewRecordingBotClient(&gotgbot.BaseBotClient{
+			DefaultRequestOpts: reqOpts,
+		}, cassette),
+	}
+
+	_, err := b.SendMessage(1, "hello", nil)
+	if err == nil {
+		t.Fatalf("expected the request to fail")
+	}
+	if !strings.Contains(err.Error(), gotgbottest.DefaultToken) {
+		t.Fatalf("expected the returned error to be left untouched, got: %v", err)
+	}
+
+	if cassette.Len() == 0 {
+		t.Fatalf("expected the failed call to be recorded")
+	}
+	if strings.Contains(cassette.String(), gotgbottest.DefaultToken) {
+		t.Errorf("expected the token to be redacted from the cassette, got %s", cassette.String())
+	}
+}
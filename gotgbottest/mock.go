@@ -0,0 +1,8 @@
+package gotgbottest
+
+import (
+	"errors"
+)
+
+// ErrNotMocked is returned by MockBot methods which have not been given an implementation.
+var ErrNotMocked = errors.New("method not mocked")
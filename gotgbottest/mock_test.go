@@ -0,0 +1,40 @@
+package gotgbottest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/PaulSonOfLars/gotgbot/v2/gotgbottest"
+)
+
+// greet depends on the BotAPI interface, rather than on the Bot, so it can be tested with a MockBot.
+func greet(b gotgbot.BotAPI, chatId int64) error {
+	_, err := b.SendMessage(chatId, "hello", &gotgbot.SendMessageOpts{ParseMode: gotgbot.ParseModeHTML})
+	return err
+}
+
+func TestMockBot(t *testing.T) {
+	var calls int
+	b := &gotgbottest.MockBot{
+		SendMessageFunc: func(_ context.Context, chatId int64, text string, opts *gotgbot.SendMessageOpts) (*gotgbot.Message, error) {
+			calls++
+			if chatId != 1 || text != "hello" || opts.ParseMode != gotgbot.ParseModeHTML {
+				t.Errorf("unexpected call: %d %q %+v", chatId, text, opts)
+			}
+			return &gotgbot.Message{Text: text}, nil
+		},
+	}
+
+	if err := greet(b, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call to SendMessage, got %d", calls)
+	}
+
+	if _, err := b.SendPhotoWithContext(context.Background(), 1, gotgbot.InputFileByID("id"), nil); !errors.Is(err, gotgbottest.ErrNotMocked) {
+		t.Errorf("expected ErrNotMocked, got %v", err)
+	}
+}
@@ -0,0 +1,577 @@
+// Package gotgbottest provides an in-process fake of the telegram Bot API, for integration testing of bots.
+//
+// The Server records every method call it receives, serves updates to getUpdates calls (or pushes them to a
+// webhook), and replies to method calls with sensible defaults; these can be overridden with scripted replies and
+// errors. Bots are pointed at the Server through the APIURL field of the gotgbot.RequestOpts:
+//
+//	s := gotgbottest.NewServer()
+//	defer s.Close()
+//
+//	b, err := s.NewBot()
+//	// or: gotgbot.NewBot(token, &gotgbot.BotOpts{BotClient: &gotgbot.BaseBotClient{
+//	//	DefaultRequestOpts: &gotgbot.RequestOpts{APIURL: s.URL},
+//	// }})
+//
+//	s.EnqueueUpdate(gotgbot.Update{Message: &gotgbot.Message{Text: "/start", Chat: gotgbot.Chat{Id: 1}}})
+//	...
+//	calls := s.CallsTo("sendMessage")
+//
+// For unit tests of code which depends on the gotgbot.BotAPI interface, MockBot allows stubbing individual methods
+// instead.
+package gotgbottest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+)
+
+var (
+	ErrNoWebhook           = errors.New("no webhook has been set")
+	ErrMissingParam        = errors.New("missing parameter")
+	ErrUnexpectedStatus    = errors.New("unexpected webhook response status")
+	ErrInvalidRequestPath  = errors.New("invalid request path")
+	ErrUnsupportedEncoding = errors.New("unsupported request encoding")
+)
+
+// DefaultToken is the token of the bot created by Server.NewBot.
+const DefaultToken = "123456789:gotgbottest"
+
+// Call represents a single method call received by the Server.
+type Call struct {
+	// Token is the token of the bot which made the call.
+	Token string
+	// Method is the name of the API method which was called, eg "sendMessage".
+	Method string
+	// Params contains all the non-file parameters of the call.
+	Params map[string]string
+	// Files contains all the files uploaded as part of the call, by field name.
+	Files map[string]File
+}
+
+// File is a file uploaded as part of a method call.
+type File struct {
+	// Name is the file name, as sent by the client.
+	Name string
+	// Data is the file contents.
+	Data []byte
+}
+
+// Param returns the value of a call parameter, or an empty string if it wasn't set.
+func (c Call) Param(key string) string {
+	return c.Params[key]
+}
+
+// DecodeParam unmarshals a JSON-encoded call parameter, such as a reply_markup, into v.
+func (c Call) DecodeParam(key string, v interface{}) error {
+	val, ok := c.Params[key]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrMissingParam, key)
+	}
+	if err := json.Unmarshal([]byte(val), v); err != nil {
+		return fmt.Errorf("failed to decode parameter %s: %w", key, err)
+	}
+	return nil
+}
+
+// HandlerFunc replies to a method call. The returned result is sent back as the result of the call.
+// If an error is returned, the call fails. *gotgbot.TelegramError errors are sent back as-is, which allows for
+// scripting specific API errors; any other error is sent back as a 500 internal server error.
+type HandlerFunc func(call Call) (interface{}, error)
+
+// Server is an in-process fake of the telegram Bot API.
+type Server struct {
+	// URL is the base URL of the server, to be used as the RequestOpts.APIURL.
+	URL string
+	// Bot is the user returned by getMe calls.
+	Bot gotgbot.User
+
+	// server is the underlying HTTP test server.
+	server *httptest.Server
+	// closed is closed when the server is shut down, to stop any hanging getUpdates calls.
+	closed chan struct{}
+	// closeOnce ensures the server is only shut down once, so Close can be called multiple times.
+	closeOnce sync.Once
+
+	// mux ensures all the fields below are concurrency-safe.
+	mux sync.Mutex
+	// calls contains all the calls received by the server, in order.
+	calls []Call
+	// handlers contains the persistent handlers for each method.
+	handlers map[string]HandlerFunc
+	// onceHandlers contains the queue of scripted one-off handlers for each method.
+	onceHandlers map[string][]HandlerFunc
+
+	// updates contains the updates which haven't been confirmed by a getUpdates call yet.
+	updates []json.RawMessage
+	// updateIds contains the ID of each update in updates.
+	updateIds []int64
+	// lastUpdateId is the ID of the most recently enqueued update.
+	lastUpdateId int64
+	// newUpdates is closed whenever a new update is enqueued, to wake up any waiting getUpdates calls.
+	newUpdates chan struct{}
+
+	// webhookURL is the URL set by the last setWebhook call.
+	webhookURL string
+	// webhookSecret is the secret token set by the last setWebhook call.
+	webhookSecret string
+	// lastMessageId is the ID of the last message returned by the default handlers.
+	lastMessageId int64
+}
+
+// NewServer starts a new fake Bot API server. It should be closed once done.
+func NewServer() *Server {
+	s := &Server{
+		Bot: gotgbot.User{
+			Id:        123456789,
+			IsBot:     true,
+			FirstName: "Test Bot",
+			Username:  "test_bot",
+		},
+		handlers:     map[string]HandlerFunc{},
+		onceHandlers: map[string][]HandlerFunc{},
+		newUpdates:   make(chan struct{}),
+		closed:       make(chan struct{}),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+	return s
+}
+
+// Close shuts down the server. It is safe to call multiple times; eg, explicitly, as well as through a defer.
+func (s *Server) Close() {
+	s.closeOnce.Do(func() {
+		// Stop any hanging getUpdates calls, so that the server can shut down.
+		close(s.closed)
+		s.server.Close()
+	})
+}
+
+// RequestOpts returns the request opts required to send requests to the server.
+func (s *Server) RequestOpts() *gotgbot.RequestOpts {
+	return &gotgbot.RequestOpts{APIURL: s.URL}
+}
+
+// NewBot creates a new bot which sends all its requests to the server, using the DefaultToken.
+func (s *Server) NewBot() (*gotgbot.Bot, error) {
+	return gotgbot.NewBot(DefaultToken, &gotgbot.BotOpts{
+		BotClient: &gotgbot.BaseBotClient{
+			Client:             http.Client{},
+			DefaultRequestOpts: s.RequestOpts(),
+		},
+		RequestOpts: s.RequestOpts(),
+	})
+}
+
+// Calls returns all the calls received by the server, in order.
+func (s *Server) Calls() []Call {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return append([]Call(nil), s.calls...)
+}
+
+// CallsTo returns all the calls to a specific method, in order.
+func (s *Server) CallsTo(method string) []Call {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	var calls []Call
+	for _, c := range s.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// ResetCalls clears the list of recorded calls.
+func (s *Server) ResetCalls() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.calls = nil
+}
+
+// Handle sets the handler used to reply to all calls to a method, replacing the default reply.
+func (s *Server) Handle(method string, h HandlerFunc) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.handlers[method] = h
+}
+
+// HandleOnce queues a handler to reply to the next call to a method. Queued handlers take precedence over the
+// handlers set through Handle, and are used in the order in which they were queued.
+func (s *Server) HandleOnce(method string, h HandlerFunc) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.onceHandlers[method] = append(s.onceHandlers[method], h)
+}
+
+// ReplyOnce queues a successful result for the next call to a method.
+func (s *Server) ReplyOnce(method string, result interface{}) {
+	s.HandleOnce(method, func(call Call) (interface{}, error) {
+		return result, nil
+	})
+}
+
+// FailOnce queues an API error for the next call to a method.
+func (s *Server) FailOnce(method string, code int, description string) {
+	s.HandleOnce(method, func(call Call) (interface{}, error) {
+		return nil, &gotgbot.TelegramError{Code: code, Description: description}
+	})
+}
+
+// FloodWaitOnce queues a "429: Too Many Requests" error for the next call to a method, asking the client to retry
+// after the given number of seconds.
+func (s *Server) FloodWaitOnce(method string, retryAfter int64) {
+	s.HandleOnce(method, func(call Call) (interface{}, error) {
+		return nil, &gotgbot.TelegramError{
+			Code:           http.StatusTooManyRequests,
+			Description:    fmt.Sprintf("Too Many Requests: retry after %d", retryAfter),
+			ResponseParams: &gotgbot.ResponseParameters{RetryAfter: retryAfter},
+		}
+	})
+}
+
+// EnqueueUpdate queues an update to be returned by getUpdates. If the update ID is not set, the next available ID is
+// used. The ID of the update is returned.
+func (s *Server) EnqueueUpdate(upd gotgbot.Update) (int64, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	upd.UpdateId = s.nextUpdateId(upd.UpdateId)
+	bs, err := json.Marshal(upd)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode update: %w", err)
+	}
+
+	s.updates = append(s.updates, bs)
+	s.updateIds = append(s.updateIds, upd.UpdateId)
+
+	// Wake up any waiting getUpdates calls.
+	close(s.newUpdates)
+	s.newUpdates = make(chan struct{})
+	return upd.UpdateId, nil
+}
+
+// PendingUpdates returns the number of enqueued updates which have not been confirmed by a getUpdates call yet.
+func (s *Server) PendingUpdates() int {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return len(s.updates)
+}
+
+// PushUpdate sends an update to the webhook set through setWebhook, along with its secret token. If the update ID is
+// not set, the next available ID is used. The ID of the update is returned.
+func (s *Server) PushUpdate(ctx context.Context, upd gotgbot.Update) (int64, error) {
+	s.mux.Lock()
+	url, secret := s.webhookURL, s.webhookSecret
+	if url == "" {
+		s.mux.Unlock()
+		return 0, ErrNoWebhook
+	}
+	upd.UpdateId = s.nextUpdateId(upd.UpdateId)
+	s.mux.Unlock()
+
+	bs, err := json.Marshal(upd)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode update: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(bs))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if secret != "" {
+		req.Header.Set("X-Telegram-Bot-Api-Secret-Token", secret)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send webhook request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("%w: %s", ErrUnexpectedStatus, resp.Status)
+	}
+	return upd.UpdateId, nil
+}
+
+// nextUpdateId returns the ID to use for a new update. Must be called with the lock held.
+func (s *Server) nextUpdateId(id int64) int64 {
+	if id == 0 {
+		id = s.lastUpdateId + 1
+	}
+	if id > s.lastUpdateId {
+		s.lastUpdateId = id
+	}
+	return id
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	call, err := parseCall(r)
+	if err != nil {
+		writeResponse(w, nil, &gotgbot.TelegramError{Code: http.StatusBadRequest, Description: err.Error()})
+		return
+	}
+
+	s.mux.Lock()
+	s.calls = append(s.calls, call)
+	h := s.handlers[call.Method]
+	if queue := s.onceHandlers[call.Method]; len(queue) > 0 {
+		h = queue[0]
+		s.onceHandlers[call.Method] = queue[1:]
+	}
+	s.mux.Unlock()
+
+	if h != nil {
+		result, err := h(call)
+		writeResponse(w, result, err)
+		return
+	}
+
+	if call.Method == "getUpdates" {
+		result, err := s.getUpdates(r.Context(), call)
+		writeResponse(w, result, err)
+		return
+	}
+
+	result, err := s.defaultReply(call)
+	writeResponse(w, result, err)
+}
+
+// parseCall decodes the incoming request into a Call.
+func parseCall(r *http.Request) (Call, error) {
+	// Paths are formatted as /bot<token>/<method>, or /bot<token>/test/<method> for the test environment.
+	path := strings.TrimPrefix(r.URL.Path, "/bot")
+	idx := strings.Index(path, "/")
+	if path == r.URL.Path || idx < 0 {
+		return Call{}, fmt.Errorf("%w: %s", ErrInvalidRequestPath, r.URL.Path)
+	}
+
+	call := Call{
+		Token:  path[:idx],
+		Method: path[strings.LastIndex(path, "/")+1:],
+		Params: map[string]string{},
+		Files:  map[string]File{},
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil && r.ContentLength != 0 {
+		return Call{}, fmt.Errorf("failed to parse content type: %w", err)
+	}
+
+	switch mediaType {
+	case "application/json", "":
+		bs, err := io.ReadAll(r.Body)
+		if err != nil {
+			return Call{}, fmt.Errorf("failed to read request body: %w", err)
+		}
+		if len(bytes.TrimSpace(bs)) == 0 || string(bytes.TrimSpace(bs)) == "null" {
+			return call, nil
+		}
+		if err := json.Unmarshal(bs, &call.Params); err != nil {
+			return Call{}, fmt.Errorf("failed to decode request body: %w", err)
+		}
+
+	case "multipart/form-data":
+		mr, err := r.MultipartReader()
+		if err != nil {
+			return Call{}, fmt.Errorf("failed to read multipart body: %w", err)
+		}
+		for {
+			p, err := mr.NextPart()
+			if errors.Is(err, io.EOF) {
+				break
+			} else if err != nil {
+				return Call{}, fmt.Errorf("failed to read multipart body: %w", err)
+			}
+
+			bs, err := io.ReadAll(p)
+			if err != nil {
+				return Call{}, fmt.Errorf("failed to read multipart field %s: %w", p.FormName(), err)
+			}
+			if p.FileName() != "" {
+				call.Files[p.FormName()] = File{Name: p.FileName(), Data: bs}
+			} else {
+				call.Params[p.FormName()] = string(bs)
+			}
+		}
+
+	default:
+		return Call{}, fmt.Errorf("%w: %s", ErrUnsupportedEncoding, mediaType)
+	}
+
+	return call, nil
+}
+
+// getUpdates serves the enqueued updates, long-polling if necessary.
+func (s *Server) getUpdates(ctx context.Context, call Call) (interface{}, error) {
+	offset, _ := strconv.ParseInt(call.Param("offset"), 10, 64)
+	limit, _ := strconv.Atoi(call.Param("limit"))
+	if limit <= 0 || limit > 100 {
+		limit = 100
+	}
+	timeout, _ := strconv.Atoi(call.Param("timeout"))
+	deadline := time.After(time.Duration(timeout) * time.Second)
+
+	for {
+		s.mux.Lock()
+		if offset != 0 {
+			// All updates before the offset are confirmed, and can be forgotten.
+			for len(s.updateIds) > 0 && s.updateIds[0] < offset {
+				s.updates = s.updates[1:]
+				s.updateIds = s.updateIds[1:]
+			}
+		}
+
+		updates := s.updates
+		if len(updates) > limit {
+			updates = updates[:limit]
+		}
+		updates = append([]json.RawMessage{}, updates...)
+		newUpdates := s.newUpdates
+		s.mux.Unlock()
+
+		if len(updates) > 0 || timeout <= 0 {
+			return updates, nil
+		}
+
+		select {
+		case <-newUpdates:
+			// Check for new updates.
+		case <-deadline:
+			return updates, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-s.closed:
+			return updates, nil
+		}
+	}
+}
+
+// defaultReply returns the default result for a method.
+func (s *Server) defaultReply(call Call) (interface{}, error) {
+	switch method := strings.ToLower(call.Method); {
+	case method == "getme":
+		return s.Bot, nil
+
+	case method == "setwebhook":
+		s.mux.Lock()
+		s.webhookURL = call.Param("url")
+		s.webhookSecret = call.Param("secret_token")
+		s.mux.Unlock()
+		return true, nil
+
+	case method == "deletewebhook":
+		s.mux.Lock()
+		s.webhookURL = ""
+		s.webhookSecret = ""
+		s.mux.Unlock()
+		return true, nil
+
+	case method == "getwebhookinfo":
+		s.mux.Lock()
+		defer s.mux.Unlock()
+		return gotgbot.WebhookInfo{Url: s.webhookURL, PendingUpdateCount: int64(len(s.updates))}, nil
+
+	case method == "sendchataction":
+		return true, nil
+
+	case method == "sendmediagroup":
+		var media []json.RawMessage
+		_ = call.DecodeParam("media", &media)
+		msgs := make([]gotgbot.Message, 0, len(media))
+		for range media {
+			msgs = append(msgs, s.newMessage(call))
+		}
+		return msgs, nil
+
+	case strings.HasPrefix(method, "send"),
+		method == "forwardmessage",
+		strings.HasPrefix(method, "edit") && call.Param("inline_message_id") == "":
+		return s.newMessage(call), nil
+
+	default:
+		return true, nil
+	}
+}
+
+// newMessage builds a plausible message from the parameters of a call.
+func (s *Server) newMessage(call Call) gotgbot.Message {
+	chatId, _ := strconv.ParseInt(call.Param("chat_id"), 10, 64)
+	chatType := gotgbot.ChatTypePrivate
+	if chatId < 0 {
+		chatType = gotgbot.ChatTypeSupergroup
+	}
+
+	messageId, _ := strconv.ParseInt(call.Param("message_id"), 10, 64)
+
+	s.mux.Lock()
+	if messageId == 0 {
+		s.lastMessageId++
+		messageId = s.lastMessageId
+	}
+	bot := s.Bot
+	s.mux.Unlock()
+
+	return gotgbot.Message{
+		MessageId: messageId,
+		From:      &bot,
+		Date:      time.Now().Unix(),
+		Chat:      gotgbot.Chat{Id: chatId, Type: chatType},
+		Text:      call.Param("text"),
+		Caption:   call.Param("caption"),
+	}
+}
+
+func writeResponse(w http.ResponseWriter, result interface{}, err error) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if err != nil {
+		var tgErr *gotgbot.TelegramError
+		if !errors.As(err, &tgErr) {
+			tgErr = &gotgbot.TelegramError{Code: http.StatusInternalServerError, Description: err.Error()}
+		}
+		if tgErr.Code == 0 {
+			tgErr.Code = http.StatusInternalServerError
+		}
+
+		w.WriteHeader(tgErr.Code)
+		_ = json.NewEncoder(w).Encode(gotgbot.Response{
+			Ok:          false,
+			ErrorCode:   tgErr.Code,
+			Description: tgErr.Description,
+			Parameters:  tgErr.ResponseParams,
+		})
+		return
+	}
+
+	bs, err := json.Marshal(result)
+	if err != nil {
+		writeResponse(w, nil, fmt.Errorf("failed to encode result: %w", err))
+		return
+	}
+	_ = json.NewEncoder(w).Encode(gotgbot.Response{
+		Ok:     true,
+		Result: bs,
+	})
+}
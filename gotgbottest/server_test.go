@@ -0,0 +1,205 @@
+package gotgbottest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext"
+	"github.com/PaulSonOfLars/gotgbot/v2/ext/handlers"
+	"github.com/PaulSonOfLars/gotgbot/v2/gotgbottest"
+)
+
+func TestServerRecordsCalls(t *testing.T) {
+	s := gotgbottest.NewServer()
+	defer s.Close()
+
+	b, err := s.NewBot()
+	if err != nil {
+		t.Fatalf("failed to create bot: %v", err)
+	}
+	if b.Username != s.Bot.Username {
+		t.Errorf("expected bot username %s, got %s", s.Bot.Username, b.Username)
+	}
+
+	msg, err := b.SendMessage(1, "hello", &gotgbot.SendMessageOpts{
+		ReplyMarkup: gotgbot.InlineKeyboardMarkup{InlineKeyboard: [][]gotgbot.InlineKeyboardButton{{
+			{Text: "button", CallbackData: "data"},
+		}}},
+	})
+	if err != nil {
+		t.Fatalf("failed to send message: %v", err)
+	}
+	if msg.Text != "hello" || msg.Chat.Id != 1 || msg.MessageId == 0 {
+		t.Errorf("unexpected default message reply: %+v", msg)
+	}
+
+	_, err = b.SendDocument(1, gotgbot.InputFileByReader("file.txt", strings.NewReader("contents")), nil)
+	if err != nil {
+		t.Fatalf("failed to send document: %v", err)
+	}
+
+	calls := s.CallsTo("sendMessage")
+	if len(calls) != 1 {
+		t.Fatalf("expected 1 sendMessage call, got %d", len(calls))
+	}
+	var markup gotgbot.InlineKeyboardMarkup
+	if err := calls[0].DecodeParam("reply_markup", &markup); err != nil {
+		t.Fatalf("failed to decode reply markup: %v", err)
+	}
+	if markup.InlineKeyboard[0][0].CallbackData != "data" {
+		t.Errorf("unexpected reply markup: %+v", markup)
+	}
+
+	docCalls := s.CallsTo("sendDocument")
+	if len(docCalls) != 1 {
+		t.Fatalf("expected 1 sendDocument call, got %d", len(docCalls))
+	}
+	if f := docCalls[0].Files["document"]; f.Name != "file.txt" || string(f.Data) != "contents" {
+		t.Errorf("unexpected uploaded file: %+v", f)
+	}
+}
+
+func TestServerScriptedErrors(t *testing.T) {
+	s := gotgbottest.NewServer()
+	defer s.Close()
+
+	b, err := s.NewBot()
+	if err != nil {
+		t.Fatalf("failed to create bot: %v", err)
+	}
+
+	s.FailOnce("sendMessage", http.StatusBadRequest, "Bad Request: chat not found")
+	_, err = b.SendMessage(1, "hello", nil)
+
+	var tgErr *gotgbot.TelegramError
+	if !errors.As(err, &tgErr) || tgErr.Code != http.StatusBadRequest || tgErr.Description != "Bad Request: chat not found" {
+		t.Fatalf("expected scripted error, got: %v", err)
+	}
+
+	// Flood waits are retried by the RetryBotClient.
+	b.BotClient = gotgbot.NewRetryBotClient(b.BotClient, nil)
+	s.FloodWaitOnce("sendMessage", 1)
+	if _, err = b.SendMessage(1, "hello", nil); err != nil {
+		t.Fatalf("expected flood wait to be retried, got: %v", err)
+	}
+	if calls := s.CallsTo("sendMessage"); len(calls) != 3 {
+		t.Errorf("expected 3 sendMessage calls, got %d", len(calls))
+	}
+}
+
+func TestServerPolling(t *testing.T) {
+	s := gotgbottest.NewServer()
+	defer s.Close()
+
+	b, err := s.NewBot()
+	if err != nil {
+		t.Fatalf("failed to create bot: %v", err)
+	}
+
+	d := ext.NewDispatcher(nil)
+	d.AddHandler(handlers.NewCommand("start", func(b *gotgbot.Bot, ctx *ext.Context) error {
+		_, err := ctx.EffectiveMessage.Reply(b, "welcome", nil)
+		return err
+	}))
+
+	u := ext.NewUpdater(d, nil)
+	err = u.StartPolling(b, &ext.PollingOpts{
+		GetUpdatesOpts: &gotgbot.GetUpdatesOpts{
+			Timeout:     1,
+			RequestOpts: &gotgbot.RequestOpts{Timeout: time.Second * 2},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to start polling: %v", err)
+	}
+
+	_, err = s.EnqueueUpdate(gotgbot.Update{Message: &gotgbot.Message{
+		MessageId: 1,
+		Text:      "/start",
+		Entities:  []gotgbot.MessageEntity{{Type: "bot_command", Offset: 0, Length: 6}},
+		Chat:      gotgbot.Chat{Id: 10, Type: "private"},
+		From:      &gotgbot.User{Id: 10, FirstName: "user"},
+	}})
+	if err != nil {
+		t.Fatalf("failed to enqueue update: %v", err)
+	}
+
+	deadline := time.Now().Add(time.Second * 5)
+	for len(s.CallsTo("sendMessage")) == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	if err := u.Stop(); err != nil {
+		t.Fatalf("failed to stop updater: %v", err)
+	}
+
+	calls := s.CallsTo("sendMessage")
+	if len(calls) != 1 {
+		t.Fatalf("expected 1 reply, got %d", len(calls))
+	}
+	if calls[0].Param("chat_id") != "10" || calls[0].Param("text") != "welcome" {
+		t.Errorf("unexpected reply: %+v", calls[0].Params)
+	}
+	if s.PendingUpdates() != 0 {
+		t.Errorf("expected the update to be confirmed, but %d updates are pending", s.PendingUpdates())
+	}
+}
+
+func TestServerWebhook(t *testing.T) {
+	s := gotgbottest.NewServer()
+	defer s.Close()
+
+	b, err := s.NewBot()
+	if err != nil {
+		t.Fatalf("failed to create bot: %v", err)
+	}
+
+	received := make(chan string, 1)
+	d := ext.NewDispatcher(nil)
+	d.AddHandler(handlers.NewMessage(nil, func(b *gotgbot.Bot, ctx *ext.Context) error {
+		received <- ctx.EffectiveMessage.Text
+		return nil
+	}))
+
+	u := ext.NewUpdater(d, nil)
+	if err := u.AddWebhook(b, "webhook", &ext.AddWebhookOpts{SecretToken: "secret"}); err != nil {
+		t.Fatalf("failed to add webhook: %v", err)
+	}
+	webhookServer := httptest.NewServer(u.GetHandlerFunc("/"))
+	defer webhookServer.Close()
+
+	if _, err := b.SetWebhook(webhookServer.URL+"/webhook", &gotgbot.SetWebhookOpts{SecretToken: "secret"}); err != nil {
+		t.Fatalf("failed to set webhook: %v", err)
+	}
+
+	if _, err := s.PushUpdate(context.Background(), gotgbot.Update{Message: &gotgbot.Message{Text: "hello"}}); err != nil {
+		t.Fatalf("failed to push update: %v", err)
+	}
+
+	select {
+	case text := <-received:
+		if text != "hello" {
+			t.Errorf("expected to receive 'hello', got %q", text)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("webhook update was not received")
+	}
+
+	if err := u.Stop(); err != nil {
+		t.Fatalf("failed to stop updater: %v", err)
+	}
+}
+
+func TestServerCloseTwice(t *testing.T) {
+	s := gotgbottest.NewServer()
+	defer s.Close()
+
+	// Closing explicitly, as well as through the defer, must not panic.
+	s.Close()
+}
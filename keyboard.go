@@ -0,0 +1,95 @@
+package gotgbot
+
+// InlineKeyboardBuilder is a helper to build InlineKeyboardMarkup objects, without nesting button literals by hand.
+// Buttons are added to the current row, which can be automatically wrapped once it reaches a given width.
+//
+//	markup := gotgbot.NewInlineKeyboardBuilder().
+//		Width(2).
+//		Callback("Yes", "yes").
+//		Callback("No", "no").
+//		Callback("Maybe", "maybe"). // Wrapped to the second row.
+//		Row().
+//		URL("Help", "https://example.com").
+//		Build()
+type InlineKeyboardBuilder struct {
+	// rows contains all the completed rows.
+	rows [][]InlineKeyboardButton
+	// current is the row buttons are currently being added to.
+	current []InlineKeyboardButton
+	// width is the maximum number of buttons per row; rows are wrapped once they reach it. If 0, rows aren't wrapped.
+	width int
+}
+
+// NewInlineKeyboardBuilder creates a new, empty, InlineKeyboardBuilder.
+func NewInlineKeyboardBuilder() *InlineKeyboardBuilder {
+	return &InlineKeyboardBuilder{}
+}
+
+// Width sets the maximum number of buttons per row; once a row is full, new buttons are added to a new row.
+// If 0, rows are never wrapped.
+func (k *InlineKeyboardBuilder) Width(width int) *InlineKeyboardBuilder {
+	k.width = width
+	return k
+}
+
+// Add adds buttons to the current row, wrapping it as needed.
+func (k *InlineKeyboardBuilder) Add(buttons ...InlineKeyboardButton) *InlineKeyboardBuilder {
+	for _, b := range buttons {
+		if k.width > 0 && len(k.current) >= k.width {
+			k.Row()
+		}
+		k.current = append(k.current, b)
+	}
+	return k
+}
+
+// Callback adds a button which sends a callback query with the given data when pressed.
+func (k *InlineKeyboardBuilder) Callback(text string, data string) *InlineKeyboardBuilder {
+	return k.Add(InlineKeyboardButton{Text: text, CallbackData: data})
+}
+
+// URL adds a button which opens the given URL when pressed.
+func (k *InlineKeyboardBuilder) URL(text string, url string) *InlineKeyboardBuilder {
+	return k.Add(InlineKeyboardButton{Text: text, Url: url})
+}
+
+// Row ends the current row, such that the next buttons are added to a new row. Any buttons given are added as a
+// complete row of their own, regardless of the configured width. Empty rows are ignored.
+func (k *InlineKeyboardBuilder) Row(buttons ...InlineKeyboardButton) *InlineKeyboardBuilder {
+	if len(k.current) > 0 {
+		k.rows = append(k.rows, k.current)
+		k.current = nil
+	}
+	if len(buttons) > 0 {
+		k.rows = append(k.rows, append([]InlineKeyboardButton(nil), buttons...))
+	}
+	return k
+}
+
+// Columns adds the buttons in new rows of the given number of columns, regardless of the configured width.
+func (k *InlineKeyboardBuilder) Columns(columns int, buttons ...InlineKeyboardButton) *InlineKeyboardBuilder {
+	if columns <= 0 {
+		columns = 1
+	}
+
+	k.Row()
+	for len(buttons) > 0 {
+		n := columns
+		if n > len(buttons) {
+			n = len(buttons)
+		}
+		k.Row(buttons[:n]...)
+		buttons = buttons[n:]
+	}
+	return k
+}
+
+// Build returns the InlineKeyboardMarkup containing all the rows added so far.
+func (k *InlineKeyboardBuilder) Build() InlineKeyboardMarkup {
+	rows := make([][]InlineKeyboardButton, 0, len(k.rows)+1)
+	rows = append(rows, k.rows...)
+	if len(k.current) > 0 {
+		rows = append(rows, k.current)
+	}
+	return InlineKeyboardMarkup{InlineKeyboard: rows}
+}
@@ -0,0 +1,61 @@
+package gotgbot_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+)
+
+func TestInlineKeyboardBuilder(t *testing.T) {
+	cb := func(data string) gotgbot.InlineKeyboardButton {
+		return gotgbot.InlineKeyboardButton{Text: data, CallbackData: data}
+	}
+
+	for name, tc := range map[string]struct {
+		build    func(k *gotgbot.InlineKeyboardBuilder) *gotgbot.InlineKeyboardBuilder
+		expected [][]gotgbot.InlineKeyboardButton
+	}{
+		"empty": {
+			build:    func(k *gotgbot.InlineKeyboardBuilder) *gotgbot.InlineKeyboardBuilder { return k.Row() },
+			expected: [][]gotgbot.InlineKeyboardButton{},
+		},
+		"single row": {
+			build: func(k *gotgbot.InlineKeyboardBuilder) *gotgbot.InlineKeyboardBuilder {
+				return k.Callback("a", "a").Callback("b", "b").Callback("c", "c")
+			},
+			expected: [][]gotgbot.InlineKeyboardButton{{cb("a"), cb("b"), cb("c")}},
+		},
+		"explicit rows": {
+			build: func(k *gotgbot.InlineKeyboardBuilder) *gotgbot.InlineKeyboardBuilder {
+				return k.Callback("a", "a").Row().Row().Callback("b", "b").Row(cb("c"), cb("d")).Callback("e", "e")
+			},
+			expected: [][]gotgbot.InlineKeyboardButton{{cb("a")}, {cb("b")}, {cb("c"), cb("d")}, {cb("e")}},
+		},
+		"wrapping": {
+			build: func(k *gotgbot.InlineKeyboardBuilder) *gotgbot.InlineKeyboardBuilder {
+				return k.Width(2).Add(cb("a"), cb("b"), cb("c")).Row().Add(cb("d")).
+					URL("link", "https://example.com")
+			},
+			expected: [][]gotgbot.InlineKeyboardButton{
+				{cb("a"), cb("b")},
+				{cb("c")},
+				{cb("d"), {Text: "link", Url: "https://example.com"}},
+			},
+		},
+		"columns": {
+			build: func(k *gotgbot.InlineKeyboardBuilder) *gotgbot.InlineKeyboardBuilder {
+				return k.Width(5).Add(cb("a")).Columns(2, cb("b"), cb("c"), cb("d")).Add(cb("e"))
+			},
+			expected: [][]gotgbot.InlineKeyboardButton{{cb("a")}, {cb("b"), cb("c")}, {cb("d")}, {cb("e")}},
+		},
+	} {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got := tc.build(gotgbot.NewInlineKeyboardBuilder()).Build()
+			if !reflect.DeepEqual(got.InlineKeyboard, tc.expected) {
+				t.Errorf("expected %+v, got %+v", tc.expected, got.InlineKeyboard)
+			}
+		})
+	}
+}
@@ -0,0 +1,648 @@
+package gotgbot
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var ErrUnsupportedParseMode = errors.New("unsupported parse mode")
+
+// ParseEntitiesError is returned when formatted text cannot be parsed. The description matches the one telegram
+// returns in its "Bad Request: can't parse entities: ..." errors.
+type ParseEntitiesError struct {
+	// Offset is the byte offset of the error in the formatted text.
+	Offset int
+	// Description describes the error.
+	Description string
+}
+
+func (e *ParseEntitiesError) Error() string {
+	return "can't parse entities: " + e.Description
+}
+
+func newParseEntitiesError(offset int, format string, args ...interface{}) *ParseEntitiesError {
+	return &ParseEntitiesError{
+		Offset:      offset,
+		Description: fmt.Sprintf(format, args...),
+	}
+}
+
+// ParseText converts formatted text into plain text and its entities, following the same rules as telegram does for
+// the given parse mode. This allows for validating formatted text before sending it, or for sending it with entities
+// instead.
+// The legacy Markdown parse mode is not supported.
+func ParseText(text string, parseMode string) (string, []MessageEntity, error) {
+	switch parseMode {
+	case ParseModeNone:
+		return text, nil, nil
+	case ParseModeHTML:
+		return ParseHTML(text)
+	case ParseModeMarkdownV2:
+		return ParseMarkdownV2(text)
+	default:
+		return "", nil, fmt.Errorf("%w: %s", ErrUnsupportedParseMode, parseMode)
+	}
+}
+
+// entityParser builds the plain text and entities of a formatted text.
+type entityParser struct {
+	// text contains the plain text parsed so far.
+	text strings.Builder
+	// length is the length of the plain text, in UTF-16 code units.
+	length int64
+	// entities contains all the entities, in the order they were opened. The length of entities which are still open
+	// is not set.
+	entities []MessageEntity
+}
+
+func (p *entityParser) write(s string) {
+	p.text.WriteString(s)
+	p.length += utf16Length(s)
+}
+
+// open opens a new entity at the current offset, returning its index.
+func (p *entityParser) open(e MessageEntity) int {
+	e.Offset = p.length
+	p.entities = append(p.entities, e)
+	return len(p.entities) - 1
+}
+
+// close sets the length of the entity, such that it ends at the current offset.
+func (p *entityParser) close(idx int) {
+	p.entities[idx].Length = p.length - p.entities[idx].Offset
+}
+
+// result returns the plain text, and the entities sorted as telegram does. Empty entities, and entities which were
+// discarded by clearing their type, are removed.
+func (p *entityParser) result() (string, []MessageEntity) {
+	entities := make([]MessageEntity, 0, len(p.entities))
+	for _, e := range p.entities {
+		if e.Length > 0 && e.Type != "" {
+			entities = append(entities, e)
+		}
+	}
+
+	sort.SliceStable(entities, func(i, j int) bool {
+		if entities[i].Offset != entities[j].Offset {
+			return entities[i].Offset < entities[j].Offset
+		}
+		return entities[i].Length > entities[j].Length
+	})
+	return p.text.String(), entities
+}
+
+// htmlTag is an HTML tag which has been opened, but not closed yet.
+type htmlTag struct {
+	// name is the name of the tag, as written.
+	name string
+	// entity is the index of the entity opened by the tag; or -1 if the tag didn't open one.
+	entity int
+}
+
+// ParseHTML converts text formatted with the HTML parse mode into plain text and its entities.
+func ParseHTML(text string) (string, []MessageEntity, error) {
+	var p entityParser
+	var stack []htmlTag
+
+	for i := 0; i < len(text); {
+		switch text[i] {
+		case '<':
+			end, err := p.parseHTMLTag(text, i, &stack)
+			if err != nil {
+				return "", nil, err
+			}
+			i = end
+
+		case '&':
+			decoded, end := decodeHTMLEntity(text, i)
+			p.write(decoded)
+			i = end
+
+		default:
+			next := strings.IndexAny(text[i:], "<&")
+			if next < 0 {
+				next = len(text) - i
+			}
+			p.write(text[i : i+next])
+			i += next
+		}
+	}
+
+	if len(stack) > 0 {
+		return "", nil, newParseEntitiesError(len(text), "Can't find end tag corresponding to start tag \"%s\"", stack[len(stack)-1].name)
+	}
+
+	s, entities := p.result()
+	return s, entities, nil
+}
+
+// decodeHTMLEntity decodes the HTML entity at the start position, returning the decoded text and the position after
+// the entity. Only numeric entities and &lt;, &gt;, &amp; and &quot; are supported; anything else is kept as is.
+func decodeHTMLEntity(text string, start int) (string, int) {
+	// Only look for the ';' within the longest supported entity, rather than in the rest of the text.
+	limit := start + 11
+	if limit > len(text) {
+		limit = len(text)
+	}
+	end := strings.IndexByte(text[start:limit], ';')
+	if end < 0 {
+		return "&", start + 1
+	}
+	end += start + 1
+
+	name := text[start+1 : end-1]
+	switch {
+	case name == "lt":
+		return "<", end
+	case name == "gt":
+		return ">", end
+	case name == "amp":
+		return "&", end
+	case name == "quot":
+		return "\"", end
+	case strings.HasPrefix(name, "#x") || strings.HasPrefix(name, "#X"):
+		if r, err := strconv.ParseUint(name[2:], 16, 32); err == nil && utf8.ValidRune(rune(r)) && r != 0 {
+			return string(rune(r)), end
+		}
+	case strings.HasPrefix(name, "#"):
+		if r, err := strconv.ParseUint(name[1:], 10, 32); err == nil && utf8.ValidRune(rune(r)) && r != 0 {
+			return string(rune(r)), end
+		}
+	}
+	return "&", start + 1
+}
+
+// parseHTMLTag parses the start or end tag at the start position, updating the stack of open tags. The position after
+// the tag is returned.
+func (p *entityParser) parseHTMLTag(text string, start int, stack *[]htmlTag) (int, error) {
+	i := start + 1
+	if i < len(text) && text[i] == '/' {
+		// End tag.
+		i++
+		nameEnd := i
+		for nameEnd < len(text) && isHTMLNameChar(text[nameEnd]) {
+			nameEnd++
+		}
+		name := strings.ToLower(text[i:nameEnd])
+
+		i = skipHTMLSpaces(text, nameEnd)
+		if i >= len(text) || text[i] != '>' {
+			return 0, newParseEntitiesError(start, "Can't find end tag at byte offset %d", start)
+		}
+
+		if len(*stack) == 0 {
+			return 0, newParseEntitiesError(start, "Unexpected end tag at byte offset %d", start)
+		}
+
+		open := (*stack)[len(*stack)-1]
+		if open.name != name {
+			return 0, newParseEntitiesError(start, "Unmatched end tag at byte offset %d, expected \"</%s>\", found \"</%s>\"", start, open.name, name)
+		}
+
+		*stack = (*stack)[:len(*stack)-1]
+		if open.entity >= 0 {
+			p.close(open.entity)
+		}
+		return i + 1, nil
+	}
+
+	// Start tag.
+	nameEnd := i
+	for nameEnd < len(text) && isHTMLNameChar(text[nameEnd]) {
+		nameEnd++
+	}
+	name := strings.ToLower(text[i:nameEnd])
+
+	attrs, end, err := parseHTMLAttributes(text, start, nameEnd)
+	if err != nil {
+		return 0, err
+	}
+
+	entity := MessageEntity{}
+	switch name {
+	case "b", "strong":
+		entity.Type = MessageEntityTypeBold
+	case "i", "em":
+		entity.Type = MessageEntityTypeItalic
+	case "u", "ins":
+		entity.Type = MessageEntityTypeUnderline
+	case "s", "strike", "del":
+		entity.Type = MessageEntityTypeStrikethrough
+	case "tg-spoiler":
+		entity.Type = MessageEntityTypeSpoiler
+	case "span":
+		if attrs["class"] != "tg-spoiler" {
+			return 0, newParseEntitiesError(start, "Tag \"span\" must have class \"tg-spoiler\" at byte offset %d", start)
+		}
+		entity.Type = MessageEntityTypeSpoiler
+	case "code":
+		if len(*stack) > 0 {
+			if parent := (*stack)[len(*stack)-1]; parent.name == "pre" && parent.entity >= 0 {
+				// <pre><code class="language-x"> sets the language of the pre entity, without adding a code entity.
+				pre := &p.entities[parent.entity]
+				if pre.Offset == p.length && pre.Language == "" {
+					pre.Language = strings.TrimPrefix(attrs["class"], "language-")
+				}
+				*stack = append(*stack, htmlTag{name: name, entity: -1})
+				return end, nil
+			}
+		}
+		entity.Type = MessageEntityTypeCode
+	case "pre":
+		entity.Type = MessageEntityTypePre
+	case "blockquote":
+		entity.Type = MessageEntityTypeBlockquote
+		if _, ok := attrs["expandable"]; ok {
+			entity.Type = MessageEntityTypeExpandableBlockquote
+		}
+	case "a":
+		href := attrs["href"]
+		if id, ok := cutPrefix(href, "tg://user?id="); ok {
+			userId, err := strconv.ParseInt(id, 10, 64)
+			if err == nil {
+				entity.Type = MessageEntityTypeTextMention
+				entity.User = &User{Id: userId}
+				break
+			}
+		}
+		if href != "" {
+			entity.Type = MessageEntityTypeTextLink
+			entity.Url = href
+		}
+	case "tg-emoji":
+		id, ok := attrs["emoji-id"]
+		if !ok {
+			return 0, newParseEntitiesError(start, "Custom emoji entity must contain a tg://emoji URL")
+		}
+		entity.Type = MessageEntityTypeCustomEmoji
+		entity.CustomEmojiId = id
+	default:
+		return 0, newParseEntitiesError(start, "Unsupported start tag \"%s\" at byte offset %d", name, start)
+	}
+
+	tag := htmlTag{name: name, entity: -1}
+	if entity.Type != "" {
+		tag.entity = p.open(entity)
+	}
+	*stack = append(*stack, tag)
+	return end, nil
+}
+
+// parseHTMLAttributes parses the attributes of a start tag, starting after its name. The attributes are returned,
+// along with the position after the tag.
+func parseHTMLAttributes(text string, tagStart int, start int) (map[string]string, int, error) {
+	attrs := map[string]string{}
+	i := start
+	for {
+		i = skipHTMLSpaces(text, i)
+		if i >= len(text) {
+			return nil, 0, newParseEntitiesError(tagStart, "Unclosed start tag at byte offset %d", tagStart)
+		}
+		if text[i] == '>' {
+			return attrs, i + 1, nil
+		}
+
+		nameEnd := i
+		for nameEnd < len(text) && isHTMLNameChar(text[nameEnd]) {
+			nameEnd++
+		}
+		if nameEnd == i {
+			return nil, 0, newParseEntitiesError(tagStart, "Empty attribute name in the tag \"%s\" at byte offset %d", text[tagStart+1:start], tagStart)
+		}
+		name := strings.ToLower(text[i:nameEnd])
+
+		i = skipHTMLSpaces(text, nameEnd)
+		if i >= len(text) || text[i] != '=' {
+			attrs[name] = ""
+			continue
+		}
+
+		i = skipHTMLSpaces(text, i+1)
+		if i >= len(text) {
+			return nil, 0, newParseEntitiesError(tagStart, "Unclosed start tag at byte offset %d", tagStart)
+		}
+
+		var value string
+		if quote := text[i]; quote == '"' || quote == '\'' {
+			valueEnd := strings.IndexByte(text[i+1:], quote)
+			if valueEnd < 0 {
+				return nil, 0, newParseEntitiesError(tagStart, "Unclosed start tag at byte offset %d", tagStart)
+			}
+			value = text[i+1 : i+1+valueEnd]
+			i += valueEnd + 2
+		} else {
+			valueEnd := i
+			for valueEnd < len(text) && !isHTMLSpace(text[valueEnd]) && text[valueEnd] != '>' {
+				valueEnd++
+			}
+			value = text[i:valueEnd]
+			i = valueEnd
+		}
+		attrs[name] = html.UnescapeString(value)
+	}
+}
+
+func isHTMLNameChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_'
+}
+
+func isHTMLSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func skipHTMLSpaces(text string, i int) int {
+	for i < len(text) && isHTMLSpace(text[i]) {
+		i++
+	}
+	return i
+}
+
+// mdV2Entity is a MarkdownV2 entity which has been opened, but not closed yet.
+type mdV2Entity struct {
+	// entity is the index of the opened entity.
+	entity int
+	// start is the byte offset of the entity in the formatted text.
+	start int
+}
+
+// mdV2EntityNames are the entity names used by telegram in its MarkdownV2 errors.
+var mdV2EntityNames = map[MessageEntityType]string{
+	MessageEntityTypeBold:                 "Bold",
+	MessageEntityTypeItalic:               "Italic",
+	MessageEntityTypeUnderline:            "Underline",
+	MessageEntityTypeStrikethrough:        "Strikethrough",
+	MessageEntityTypeSpoiler:              "Spoiler",
+	MessageEntityTypeCode:                 "Code",
+	MessageEntityTypePre:                  "Pre",
+	MessageEntityTypeTextLink:             "TextUrl",
+	MessageEntityTypeCustomEmoji:          "CustomEmoji",
+	MessageEntityTypeBlockquote:           "BlockQuote",
+	MessageEntityTypeExpandableBlockquote: "ExpandableBlockQuote",
+}
+
+// ParseMarkdownV2 converts text formatted with the MarkdownV2 parse mode into plain text and its entities.
+func ParseMarkdownV2(text string) (string, []MessageEntity, error) {
+	var p entityParser
+	var stack []mdV2Entity
+	// quote is the blockquote currently open, if any. Blockquotes are tracked separately, since they are delimited by
+	// lines rather than by closing characters.
+	var quote *mdV2Entity
+
+	top := func() MessageEntityType {
+		if len(stack) == 0 {
+			return ""
+		}
+		return p.entities[stack[len(stack)-1].entity].Type
+	}
+
+	closeQuote := func(offset int) error {
+		if quote == nil {
+			return nil
+		}
+		if len(stack) > 0 {
+			open := stack[len(stack)-1]
+			return newParseEntitiesError(offset, "Can't find end of %s entity at byte offset %d", mdV2EntityNames[p.entities[open.entity].Type], open.start)
+		}
+		p.close(quote.entity)
+		quote = nil
+		return nil
+	}
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+
+		if c == '\\' && i+1 < len(text) && text[i+1] > 0 && text[i+1] <= 126 {
+			p.write(text[i+1 : i+2])
+			i++
+			continue
+		}
+
+		inCode := top() == MessageEntityTypeCode || top() == MessageEntityTypePre
+
+		if !inCode && c == '\r' {
+			// Carriage returns are ignored; they can be used to separate ambiguous delimiters, such as "_\r__".
+			continue
+		}
+		if inCode && quote != nil && c == '>' && text[i-1] == '\n' {
+			// Lines of code blocks inside blockquotes are quoted too.
+			continue
+		}
+
+		if !inCode && c == '\n' && quote != nil && (i+1 == len(text) || text[i+1] != '>') {
+			// The next line is not quoted, so the quote ends here; the line break is not part of it.
+			if err := closeQuote(i); err != nil {
+				return "", nil, err
+			}
+		}
+
+		if !inCode && (i == 0 || text[i-1] == '\n') {
+			// Lines starting with ">" are part of a blockquote; "**>" starts an expandable blockquote.
+			switch {
+			case c == '>':
+				if quote == nil {
+					quote = &mdV2Entity{entity: p.open(MessageEntity{Type: MessageEntityTypeBlockquote}), start: i}
+				}
+				continue
+			case strings.HasPrefix(text[i:], "**>") && quote == nil:
+				quote = &mdV2Entity{entity: p.open(MessageEntity{Type: MessageEntityTypeExpandableBlockquote}), start: i}
+				i += 2
+				continue
+			}
+		}
+
+		if inCode {
+			switch {
+			case top() == MessageEntityTypeCode && c == '`':
+				p.close(stack[len(stack)-1].entity)
+				stack = stack[:len(stack)-1]
+			case top() == MessageEntityTypePre && strings.HasPrefix(text[i:], "```"):
+				p.close(stack[len(stack)-1].entity)
+				stack = stack[:len(stack)-1]
+				i += 2
+			default:
+				_, size := utf8.DecodeRuneInString(text[i:])
+				p.write(text[i : i+size])
+				i += size - 1
+			}
+			continue
+		}
+
+		switch c {
+		case '_', '*', '~', '|', '[', ']', '(', ')', '`', '>', '#', '+', '-', '=', '{', '}', '.', '!':
+		default:
+			_, size := utf8.DecodeRuneInString(text[i:])
+			p.write(text[i : i+size])
+			i += size - 1
+			continue
+		}
+
+		// Check whether this closes the innermost entity.
+		if t := top(); t != "" {
+			closing := false
+			//exhaustive:ignore
+			switch t {
+			case MessageEntityTypeBold:
+				closing = c == '*'
+			case MessageEntityTypeItalic:
+				closing = c == '_' && !strings.HasPrefix(text[i:], "__")
+			case MessageEntityTypeUnderline:
+				closing = strings.HasPrefix(text[i:], "__")
+			case MessageEntityTypeStrikethrough:
+				closing = c == '~'
+			case MessageEntityTypeSpoiler:
+				closing = strings.HasPrefix(text[i:], "||")
+			case MessageEntityTypeTextLink, MessageEntityTypeCustomEmoji:
+				closing = c == ']'
+			}
+
+			if closing {
+				open := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+
+				//exhaustive:ignore
+				switch t {
+				case MessageEntityTypeUnderline, MessageEntityTypeSpoiler:
+					i++
+				case MessageEntityTypeTextLink, MessageEntityTypeCustomEmoji:
+					url, end, err := parseMDV2URL(text, i+1)
+					if err != nil {
+						return "", nil, err
+					}
+					i = end - 1
+					if !setMDV2URL(&p.entities[open.entity], url) {
+						if t == MessageEntityTypeCustomEmoji {
+							return "", nil, newParseEntitiesError(open.start, "Custom emoji entity must contain a tg://emoji URL")
+						}
+						// Invalid links are kept as plain text.
+						p.entities[open.entity].Type = ""
+					}
+				}
+				p.close(open.entity)
+				continue
+			}
+		}
+
+		if quote != nil && p.entities[quote.entity].Type == MessageEntityTypeExpandableBlockquote && strings.HasPrefix(text[i:], "||") &&
+			(i+2 == len(text) || text[i+2] == '\n') {
+			// "||" at the end of a line ends an expandable blockquote.
+			if err := closeQuote(i); err != nil {
+				return "", nil, err
+			}
+			i++
+			continue
+		}
+
+		// Otherwise, this opens a new entity.
+		var entity MessageEntity
+		start := i
+		switch {
+		case strings.HasPrefix(text[i:], "__"):
+			entity.Type = MessageEntityTypeUnderline
+			i++
+		case c == '_':
+			entity.Type = MessageEntityTypeItalic
+		case c == '*':
+			entity.Type = MessageEntityTypeBold
+		case c == '~':
+			entity.Type = MessageEntityTypeStrikethrough
+		case strings.HasPrefix(text[i:], "||"):
+			entity.Type = MessageEntityTypeSpoiler
+			i++
+		case c == '[':
+			entity.Type = MessageEntityTypeTextLink
+		case strings.HasPrefix(text[i:], "!["):
+			entity.Type = MessageEntityTypeCustomEmoji
+			i++
+		case strings.HasPrefix(text[i:], "```"):
+			entity.Type = MessageEntityTypePre
+			i += 3
+			// The language is everything up to the first line break, if any.
+			if end := strings.IndexAny(text[i:], "\n`"); end >= 0 && text[i+end] == '\n' {
+				entity.Language = text[i : i+end]
+				i += end
+			} else {
+				i--
+			}
+		case c == '`':
+			entity.Type = MessageEntityTypeCode
+		default:
+			return "", nil, newParseEntitiesError(i, "Character '%c' is reserved and must be escaped with the preceding '\\'", c)
+		}
+
+		stack = append(stack, mdV2Entity{entity: p.open(entity), start: start})
+	}
+
+	if len(stack) > 0 {
+		open := stack[len(stack)-1]
+		return "", nil, newParseEntitiesError(len(text), "Can't find end of %s entity at byte offset %d", mdV2EntityNames[p.entities[open.entity].Type], open.start)
+	}
+	if quote != nil {
+		p.close(quote.entity)
+	}
+
+	s, entities := p.result()
+	return s, entities, nil
+}
+
+// parseMDV2URL parses the "(url)" part of a MarkdownV2 link, starting at the given position. If there is no URL, an
+// empty string is returned.
+func parseMDV2URL(text string, start int) (string, int, error) {
+	if start >= len(text) || text[start] != '(' {
+		return "", start, nil
+	}
+
+	var url strings.Builder
+	for i := start + 1; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			if i+1 < len(text) && text[i+1] > 0 && text[i+1] <= 126 {
+				url.WriteByte(text[i+1])
+				i++
+				continue
+			}
+		case ')':
+			return url.String(), i + 1, nil
+		}
+		url.WriteByte(text[i])
+	}
+	return "", 0, newParseEntitiesError(start, "Can't find end of a URL at byte offset %d", start)
+}
+
+// setMDV2URL sets the URL of a link or custom emoji entity. False is returned if the URL isn't valid for the entity.
+func setMDV2URL(e *MessageEntity, url string) bool {
+	if e.Type == MessageEntityTypeCustomEmoji {
+		id, ok := cutPrefix(url, "tg://emoji?id=")
+		if !ok || id == "" {
+			return false
+		}
+		e.CustomEmojiId = id
+		return true
+	}
+
+	if id, ok := cutPrefix(url, "tg://user?id="); ok {
+		if userId, err := strconv.ParseInt(id, 10, 64); err == nil {
+			e.Type = MessageEntityTypeTextMention
+			e.User = &User{Id: userId}
+			return true
+		}
+	}
+
+	if url == "" {
+		return false
+	}
+	e.Url = url
+	return true
+}
+
+// cutPrefix returns the string without the given prefix, and whether the prefix was found.
+func cutPrefix(s string, prefix string) (string, bool) {
+	if !strings.HasPrefix(s, prefix) {
+		return s, false
+	}
+	return s[len(prefix):], true
+}
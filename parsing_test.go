@@ -0,0 +1,316 @@
+package gotgbot_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+)
+
+func TestParseHTML(t *testing.T) {
+	for name, tc := range map[string]struct {
+		text     string
+		expText  string
+		expEnts  []gotgbot.MessageEntity
+		expError string
+	}{
+		"plain": {
+			text:    "hello world",
+			expText: "hello world",
+		},
+		"escaped": {
+			text:    "a &lt;b&gt; &amp; &quot;c&quot; &#65;&#x42; &unknown; & d",
+			expText: `a <b> & "c" AB &unknown; & d`,
+		},
+		"long entity name": {
+			text:    "&verylongname; &&&&&;",
+			expText: "&verylongname; &&&&&;",
+		},
+		"simple entities": {
+			text:    "<b>bold</b> <strong>strong</strong> <i>i</i><em>em</em> <u>u</u><ins>ins</ins> <s>s</s><strike>s</strike><del>d</del>",
+			expText: "bold strong iem uins ssd",
+			expEnts: []gotgbot.MessageEntity{
+				{Type: "bold", Offset: 0, Length: 4},
+				{Type: "bold", Offset: 5, Length: 6},
+				{Type: "italic", Offset: 12, Length: 1},
+				{Type: "italic", Offset: 13, Length: 2},
+				{Type: "underline", Offset: 16, Length: 1},
+				{Type: "underline", Offset: 17, Length: 3},
+				{Type: "strikethrough", Offset: 21, Length: 1},
+				{Type: "strikethrough", Offset: 22, Length: 1},
+				{Type: "strikethrough", Offset: 23, Length: 1},
+			},
+		},
+		"nested": {
+			text:    "<b>bold <i>both</i></b>",
+			expText: "bold both",
+			expEnts: []gotgbot.MessageEntity{
+				{Type: "bold", Offset: 0, Length: 9},
+				{Type: "italic", Offset: 5, Length: 4},
+			},
+		},
+		"same span": {
+			text:    "<b><i>x</i></b>",
+			expText: "x",
+			expEnts: []gotgbot.MessageEntity{
+				{Type: "bold", Offset: 0, Length: 1},
+				{Type: "italic", Offset: 0, Length: 1},
+			},
+		},
+		"spoilers": {
+			text:    `<tg-spoiler>a</tg-spoiler><span class="tg-spoiler">b</span>`,
+			expText: "ab",
+			expEnts: []gotgbot.MessageEntity{
+				{Type: "spoiler", Offset: 0, Length: 1},
+				{Type: "spoiler", Offset: 1, Length: 1},
+			},
+		},
+		"links": {
+			text:    `<a href="https://example.com?a=1&amp;b=2">link</a> <a href='tg://user?id=123'>user</a> <a>none</a>`,
+			expText: "link user none",
+			expEnts: []gotgbot.MessageEntity{
+				{Type: "text_link", Offset: 0, Length: 4, Url: "https://example.com?a=1&b=2"},
+				{Type: "text_mention", Offset: 5, Length: 4, User: &gotgbot.User{Id: 123}},
+			},
+		},
+		"code": {
+			text:    `<code>a&lt;b</code> <pre>pre</pre> <pre><code class="language-go">x := 1</code></pre>`,
+			expText: "a<b pre x := 1",
+			expEnts: []gotgbot.MessageEntity{
+				{Type: "code", Offset: 0, Length: 3},
+				{Type: "pre", Offset: 4, Length: 3},
+				{Type: "pre", Offset: 8, Length: 6, Language: "go"},
+			},
+		},
+		"blockquotes": {
+			text:    "<blockquote>a</blockquote><blockquote expandable>b</blockquote>",
+			expText: "ab",
+			expEnts: []gotgbot.MessageEntity{
+				{Type: "blockquote", Offset: 0, Length: 1},
+				{Type: "expandable_blockquote", Offset: 1, Length: 1},
+			},
+		},
+		"custom emoji": {
+			text:    `<tg-emoji emoji-id="5368324170671202286">👍</tg-emoji>!`,
+			expText: "👍!",
+			expEnts: []gotgbot.MessageEntity{
+				{Type: "custom_emoji", Offset: 0, Length: 2, CustomEmojiId: "5368324170671202286"},
+			},
+		},
+		"utf16 offsets": {
+			text:    "😀 <b>😀</b>",
+			expText: "😀 😀",
+			expEnts: []gotgbot.MessageEntity{
+				{Type: "bold", Offset: 3, Length: 2},
+			},
+		},
+		"empty entities are dropped": {
+			text:    "<b></b>a",
+			expText: "a",
+		},
+		"uppercase tags": {
+			text:    "<B>a</B>",
+			expText: "a",
+			expEnts: []gotgbot.MessageEntity{
+				{Type: "bold", Offset: 0, Length: 1},
+			},
+		},
+		"unsupported tag": {
+			text:     "a <blink>b</blink>",
+			expError: `can't parse entities: Unsupported start tag "blink" at byte offset 2`,
+		},
+		"unexpected end tag": {
+			text:     "a</b>",
+			expError: "can't parse entities: Unexpected end tag at byte offset 1",
+		},
+		"unmatched end tag": {
+			text:     "<b><i>a</b></i>",
+			expError: `can't parse entities: Unmatched end tag at byte offset 7, expected "</i>", found "</b>"`,
+		},
+		"unclosed tag": {
+			text:     "<b>a",
+			expError: `can't parse entities: Can't find end tag corresponding to start tag "b"`,
+		},
+		"unclosed start tag": {
+			text:     `<a href="x>a`,
+			expError: "can't parse entities: Unclosed start tag at byte offset 0",
+		},
+	} {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			text, ents, err := gotgbot.ParseHTML(tc.text)
+			checkParseResult(t, text, ents, err, tc.expText, tc.expEnts, tc.expError)
+		})
+	}
+}
+
+func TestParseMarkdownV2(t *testing.T) {
+	for name, tc := range map[string]struct {
+		text     string
+		expText  string
+		expEnts  []gotgbot.MessageEntity
+		expError string
+	}{
+		"plain": {
+			text:    "hello world",
+			expText: "hello world",
+		},
+		"escaped": {
+			text:    `1\. a\_b \*c\* \\ \[d\]\(e\) \!`,
+			expText: `1. a_b *c* \ [d](e) !`,
+		},
+		"simple entities": {
+			text:    "*b* _i_ __u__ ~s~ ||sp||",
+			expText: "b i u s sp",
+			expEnts: []gotgbot.MessageEntity{
+				{Type: "bold", Offset: 0, Length: 1},
+				{Type: "italic", Offset: 2, Length: 1},
+				{Type: "underline", Offset: 4, Length: 1},
+				{Type: "strikethrough", Offset: 6, Length: 1},
+				{Type: "spoiler", Offset: 8, Length: 2},
+			},
+		},
+		"nested": {
+			text:    "*bold _both_*",
+			expText: "bold both",
+			expEnts: []gotgbot.MessageEntity{
+				{Type: "bold", Offset: 0, Length: 9},
+				{Type: "italic", Offset: 5, Length: 4},
+			},
+		},
+		"links": {
+			text:    `[link](https://example.com/\)) [user](tg://user?id=123)`,
+			expText: "link user",
+			expEnts: []gotgbot.MessageEntity{
+				{Type: "text_link", Offset: 0, Length: 4, Url: "https://example.com/)"},
+				{Type: "text_mention", Offset: 5, Length: 4, User: &gotgbot.User{Id: 123}},
+			},
+		},
+		"custom emoji": {
+			text:    "![👍](tg://emoji?id=5368324170671202286)",
+			expText: "👍",
+			expEnts: []gotgbot.MessageEntity{
+				{Type: "custom_emoji", Offset: 0, Length: 2, CustomEmojiId: "5368324170671202286"},
+			},
+		},
+		"code": {
+			text:    "`a*b\\`` ```go\nx := 1``` ```\nplain```",
+			expText: "a*b` x := 1 plain",
+			expEnts: []gotgbot.MessageEntity{
+				{Type: "code", Offset: 0, Length: 4},
+				{Type: "pre", Offset: 5, Length: 6, Language: "go"},
+				{Type: "pre", Offset: 12, Length: 5},
+			},
+		},
+		"blockquote": {
+			text:    ">line 1\n>line 2\nafter",
+			expText: "line 1\nline 2\nafter",
+			expEnts: []gotgbot.MessageEntity{
+				{Type: "blockquote", Offset: 0, Length: 13},
+			},
+		},
+		"expandable blockquote": {
+			text:    "**>line 1\n>line 2||\nafter",
+			expText: "line 1\nline 2\nafter",
+			expEnts: []gotgbot.MessageEntity{
+				{Type: "expandable_blockquote", Offset: 0, Length: 13},
+			},
+		},
+		"utf16 offsets": {
+			text:    "😀 *😀*",
+			expText: "😀 😀",
+			expEnts: []gotgbot.MessageEntity{
+				{Type: "bold", Offset: 3, Length: 2},
+			},
+		},
+		"reserved character": {
+			text:     "hello.",
+			expError: `can't parse entities: Character '.' is reserved and must be escaped with the preceding '\'`,
+		},
+		"unclosed entity": {
+			text:     "a *b",
+			expError: "can't parse entities: Can't find end of Bold entity at byte offset 2",
+		},
+		"unclosed code": {
+			text:     "a `b",
+			expError: "can't parse entities: Can't find end of Code entity at byte offset 2",
+		},
+		"unclosed url": {
+			text:     "[a](b",
+			expError: "can't parse entities: Can't find end of a URL at byte offset 3",
+		},
+	} {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			text, ents, err := gotgbot.ParseMarkdownV2(tc.text)
+			checkParseResult(t, text, ents, err, tc.expText, tc.expEnts, tc.expError)
+		})
+	}
+}
+
+func checkParseResult(t *testing.T, text string, ents []gotgbot.MessageEntity, err error, expText string, expEnts []gotgbot.MessageEntity, expError string) {
+	t.Helper()
+
+	if expError != "" {
+		var parseErr *gotgbot.ParseEntitiesError
+		if !errors.As(err, &parseErr) {
+			t.Fatalf("expected a ParseEntitiesError, got %v", err)
+		}
+		if err.Error() != expError {
+			t.Fatalf("expected error %q, got %q", expError, err.Error())
+		}
+		return
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if text != expText {
+		t.Errorf("expected text %q, got %q", expText, text)
+	}
+	if len(ents) == 0 && len(expEnts) == 0 {
+		return
+	}
+	if !reflect.DeepEqual(ents, expEnts) {
+		t.Errorf("expected entities:\n%+v\ngot:\n%+v", expEnts, ents)
+	}
+}
+
+func TestParseText(t *testing.T) {
+	if _, _, err := gotgbot.ParseText("*a*", gotgbot.ParseModeMarkdown); !errors.Is(err, gotgbot.ErrUnsupportedParseMode) {
+		t.Errorf("expected ErrUnsupportedParseMode, got %v", err)
+	}
+
+	text, ents, err := gotgbot.ParseText("<b>a</b>", gotgbot.ParseModeNone)
+	if err != nil || text != "<b>a</b>" || ents != nil {
+		t.Errorf("expected text to be unchanged without a parse mode, got %q %+v %v", text, ents, err)
+	}
+}
+
+func TestParseTextBuilderRoundTrip(t *testing.T) {
+	tb := gotgbot.NewTextBuilder().
+		Text("Hi <*user*> & [friends]! ").
+		Start(gotgbot.MessageEntity{Type: "bold"}).Text("bold ").Italic("both_").End().
+		Text(" ").Link("a (link)", "https://example.com/?a=1&b=(2)").
+		Text(" ").Mention("me", 123).
+		Text(" ").Code("x := `y` \\ <z>").
+		Text(" ").Pre("func() {}", "go").
+		Text(" ").Spoiler("secret").
+		Text(" ").CustomEmoji("👍", "5368324170671202286")
+	expEnts := tb.Entities()
+
+	for name, tc := range map[string]struct {
+		text  string
+		parse func(string) (string, []gotgbot.MessageEntity, error)
+	}{
+		"html":       {text: tb.HTML(), parse: gotgbot.ParseHTML},
+		"markdownv2": {text: tb.MarkdownV2(), parse: gotgbot.ParseMarkdownV2},
+	} {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			text, ents, err := tc.parse(tc.text)
+			checkParseResult(t, text, ents, err, tb.String(), expEnts, "")
+		})
+	}
+}
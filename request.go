@@ -41,6 +41,20 @@ type BaseBotClient struct {
 	DefaultRequestOpts *RequestOpts
 }
 
+// defaultBotClient returns the given client, or a default BaseBotClient if it is nil.
+// It is used by the BotClient wrappers, which inline the wrapped client so that they only need to redefine
+// RequestWithContext.
+func defaultBotClient(client BotClient) BotClient {
+	if client != nil {
+		return client
+	}
+	return &BaseBotClient{
+		Client:             http.Client{},
+		UseTestEnvironment: false,
+		DefaultRequestOpts: nil,
+	}
+}
+
 type Response struct {
 	// Ok: if true, request was successful, and result can be found in the Result field.
 	// If false, error can be explained in the Description.
@@ -177,6 +191,16 @@ func (bot *BaseBotClient) RequestWithContext(parentCtx context.Context, token st
 
 	var r Response
 	if err = json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		if resp.StatusCode >= http.StatusInternalServerError {
+			// Proxies in front of the bot API may return non-JSON bodies on server errors; keep the status code, so
+			// callers can still tell that this was a server-side failure.
+			return nil, &TelegramError{
+				Method:      method,
+				Params:      params,
+				Code:        resp.StatusCode,
+				Description: resp.Status,
+			}
+		}
 		return nil, fmt.Errorf("failed to decode POST request to %s: %w", method, err)
 	}
 
@@ -0,0 +1,471 @@
+package gotgbot
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit defines the maximum number of requests which can be sent over a period of time.
+type RateLimit struct {
+	// Requests is the number of requests allowed within the period.
+	Requests int
+	// Per is the duration of the period.
+	Per time.Duration
+}
+
+var (
+	// DefaultGlobalRateLimit is the default limit applied to all rate limited requests: ~30 messages per second.
+	DefaultGlobalRateLimit = RateLimit{Requests: 30, Per: time.Second}
+	// DefaultPrivateChatRateLimit is the default limit applied to each private chat: 1 message per second.
+	DefaultPrivateChatRateLimit = RateLimit{Requests: 1, Per: time.Second}
+	// DefaultGroupChatRateLimit is the default limit applied to each group or channel: 20 messages per minute.
+	DefaultGroupChatRateLimit = RateLimit{Requests: 20, Per: time.Minute}
+)
+
+// RequestPriority determines how the RateLimitedBotClient schedules a request.
+type RequestPriority int
+
+const (
+	// PriorityNormal requests are subject to the global and per-chat rate limits.
+	PriorityNormal RequestPriority = iota
+	// PriorityHigh requests skip the queue entirely, and are sent straight away. They are not counted against
+	// any limits. This is useful for requests which need to happen quickly, such as answering callback queries.
+	PriorityHigh
+	// PriorityLow requests are subject to the same limits as PriorityNormal requests, but are only sent when no
+	// PriorityNormal requests are waiting. This is useful for background jobs, such as broadcasts.
+	PriorityLow
+)
+
+type requestPriorityKey struct{}
+
+// WithRequestPriority returns a context which overrides the priority of any requests made with it.
+// This can be used to mark specific requests (eg, broadcasts) as low priority.
+func WithRequestPriority(ctx context.Context, p RequestPriority) context.Context {
+	return context.WithValue(ctx, requestPriorityKey{}, p)
+}
+
+// DefaultRequestPriority gives PriorityHigh to query answers (which have a very short time to live) and to getUpdates.
+// All other methods get PriorityNormal, and are subject to the global limit; see RateLimitedBotClient for which of them
+// also count against the per-chat limits.
+func DefaultRequestPriority(method string, _ map[string]string) RequestPriority {
+	if strings.HasPrefix(method, "answer") || method == "getUpdates" {
+		return PriorityHigh
+	}
+	return PriorityNormal
+}
+
+var _ BotClient = &RateLimitedBotClient{}
+
+// RateLimitedBotClient is a BotClient which wraps an existing BotClient to throttle outgoing requests, such that they
+// follow telegram's documented limits (https://core.telegram.org/bots/faq#my-bot-is-hitting-limits-how-do-i-avoid-this).
+//
+// Requests are queued by the chat_id they target. Chats are served in a round-robin fashion, so that a single busy
+// chat cannot starve the others. Queued requests are abandoned as soon as their context is done.
+//
+// Telegram's per-chat limits only apply to requests which send something to a chat. Requests without a chat_id
+// (eg, getFile), and get* methods which only read a chat (eg, getChat or getChatMember), are therefore only subject to
+// the global limit.
+type RateLimitedBotClient struct {
+	BotClient
+
+	// Priority determines the priority of each request. The priority can be overridden for specific requests by
+	// using WithRequestPriority.
+	Priority func(method string, params map[string]string) RequestPriority
+
+	// global tracks the requests sent to all chats.
+	global slidingWindow
+	// privateLimit is the limit applied to each private chat.
+	privateLimit RateLimit
+	// groupLimit is the limit applied to each group, supergroup or channel.
+	groupLimit RateLimit
+
+	// mu protects all the scheduling state below.
+	mu sync.Mutex
+	// chats tracks the requests sent to each chat.
+	chats map[string]*slidingWindow
+	// lanes contains the waiting requests, by priority. Lower indexes are served first.
+	lanes [2]requestLane
+	// running is true while the scheduling goroutine is running.
+	running bool
+	// wake is used to notify the scheduling goroutine that the queues have changed.
+	wake chan struct{}
+	// lastCleanup is the last time idle chats were removed from the chats map.
+	lastCleanup time.Time
+}
+
+// RateLimitedBotClientOpts declares all optional parameters for the NewRateLimitedBotClient function.
+type RateLimitedBotClientOpts struct {
+	// GlobalLimit is the limit applied across all chats. Defaults to DefaultGlobalRateLimit.
+	GlobalLimit RateLimit
+	// PrivateChatLimit is the limit applied to each private chat. Defaults to DefaultPrivateChatRateLimit.
+	PrivateChatLimit RateLimit
+	// GroupChatLimit is the limit applied to each group, supergroup or channel. Defaults to DefaultGroupChatRateLimit.
+	GroupChatLimit RateLimit
+	// Priority determines the priority of each request. Defaults to DefaultRequestPriority.
+	Priority func(method string, params map[string]string) RequestPriority
+}
+
+// NewRateLimitedBotClient wraps an existing BotClient to throttle outgoing requests.
+// If the client is nil, a BaseBotClient is used.
+func NewRateLimitedBotClient(client BotClient, opts *RateLimitedBotClientOpts) *RateLimitedBotClient {
+	globalLimit := DefaultGlobalRateLimit
+	privateLimit := DefaultPrivateChatRateLimit
+	groupLimit := DefaultGroupChatRateLimit
+	priority := DefaultRequestPriority
+
+	if opts != nil {
+		if opts.GlobalLimit.valid() {
+			globalLimit = opts.GlobalLimit
+		}
+		if opts.PrivateChatLimit.valid() {
+			privateLimit = opts.PrivateChatLimit
+		}
+		if opts.GroupChatLimit.valid() {
+			groupLimit = opts.GroupChatLimit
+		}
+		if opts.Priority != nil {
+			priority = opts.Priority
+		}
+	}
+
+	return &RateLimitedBotClient{
+		BotClient:    defaultBotClient(client),
+		Priority:     priority,
+		global:       slidingWindow{limit: globalLimit},
+		privateLimit: privateLimit,
+		groupLimit:   groupLimit,
+		chats:        map[string]*slidingWindow{},
+		wake:         make(chan struct{}, 1),
+	}
+}
+
+// RequestWithContext waits for the request to be allowed by the rate limits, and then calls the wrapped BotClient.
+// If the context is done while waiting, the context error is returned.
+func (c *RateLimitedBotClient) RequestWithContext(ctx context.Context, token string, method string, params map[string]string, data map[string]FileReader, opts *RequestOpts) (json.RawMessage, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if err := c.wait(ctx, method, params); err != nil {
+		return nil, err
+	}
+
+	return c.BotClient.RequestWithContext(ctx, token, method, params, data, opts)
+}
+
+// wait blocks until the request is allowed to be sent, or the context is done.
+func (c *RateLimitedBotClient) wait(ctx context.Context, method string, params map[string]string) error {
+	priority, ok := ctx.Value(requestPriorityKey{}).(RequestPriority)
+	if !ok {
+		priority = PriorityNormal
+		if c.Priority != nil {
+			priority = c.Priority(method, params)
+		}
+	}
+
+	if priority == PriorityHigh {
+		return nil
+	}
+
+	w := &waitingRequest{chatId: limitedChatId(method, params), ready: make(chan struct{})}
+
+	c.mu.Lock()
+	if priority == PriorityLow {
+		c.lanes[1].push(w)
+	} else {
+		c.lanes[0].push(w)
+	}
+	c.notify()
+	c.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if w.granted {
+			// The request was allowed at the same time the context finished; the slot has been used up either way.
+			return ctx.Err()
+		}
+		c.lanes[0].remove(w)
+		c.lanes[1].remove(w)
+		c.notify()
+		return ctx.Err()
+	}
+}
+
+// limitedChatId returns the chat whose limits apply to a request; or "", if only the global limit applies.
+func limitedChatId(method string, params map[string]string) string {
+	if strings.HasPrefix(method, "get") {
+		// Reading a chat doesn't send anything to it.
+		return ""
+	}
+	return params["chat_id"]
+}
+
+// notify ensures that the scheduling goroutine is running, and wakes it up. Must be called with the lock held.
+func (c *RateLimitedBotClient) notify() {
+	if !c.running {
+		c.running = true
+		go c.run()
+		return
+	}
+
+	select {
+	case c.wake <- struct{}{}:
+	default:
+		// A wakeup is already pending.
+	}
+}
+
+// run is the scheduling loop; it allows waiting requests through as soon as the limits permit it.
+// It exits once there are no more waiting requests.
+func (c *RateLimitedBotClient) run() {
+	for {
+		c.mu.Lock()
+		next, ok := c.schedule(time.Now())
+		if !ok {
+			c.running = false
+			c.mu.Unlock()
+			return
+		}
+		c.mu.Unlock()
+
+		t := time.NewTimer(next)
+		select {
+		case <-t.C:
+		case <-c.wake:
+			t.Stop()
+		}
+	}
+}
+
+// schedule allows as many requests through as possible, and returns how long to wait before the next request can be
+// scheduled. Returns false if there are no waiting requests. Must be called with the lock held.
+func (c *RateLimitedBotClient) schedule(now time.Time) (time.Duration, bool) {
+	c.cleanup(now)
+
+	for {
+		if c.lanes[0].empty() && c.lanes[1].empty() {
+			return 0, false
+		}
+
+		if next := c.global.nextAllowed(now); next.After(now) {
+			return next.Sub(now), true
+		}
+
+		w := c.lanes[0].pop(c.chatReady(now))
+		if w == nil {
+			w = c.lanes[1].pop(c.chatReady(now))
+		}
+		if w == nil {
+			// No chat is ready; wait for the first one which will be.
+			return c.nextChatReady(now).Sub(now), true
+		}
+
+		c.global.record(now)
+		if w.chatId != "" {
+			c.chatWindow(w.chatId).record(now)
+		}
+		w.granted = true
+		close(w.ready)
+	}
+}
+
+// chatReady returns a function to check if a request can be sent to a chat.
+func (c *RateLimitedBotClient) chatReady(now time.Time) func(chatId string) bool {
+	return func(chatId string) bool {
+		if chatId == "" {
+			// Requests without a chat are only limited globally.
+			return true
+		}
+		return !c.chatWindow(chatId).nextAllowed(now).After(now)
+	}
+}
+
+// nextChatReady returns the first time at which any of the waiting chats will be allowed to send a request.
+func (c *RateLimitedBotClient) nextChatReady(now time.Time) time.Time {
+	var next time.Time
+	for _, l := range c.lanes {
+		for _, chatId := range l.order {
+			if chatId == "" {
+				continue
+			}
+			t := c.chatWindow(chatId).nextAllowed(now)
+			if next.IsZero() || t.Before(next) {
+				next = t
+			}
+		}
+	}
+	return next
+}
+
+// chatWindow returns the sliding window tracking requests to a given chat.
+func (c *RateLimitedBotClient) chatWindow(chatId string) *slidingWindow {
+	w, ok := c.chats[chatId]
+	if !ok {
+		w = &slidingWindow{limit: c.chatLimit(chatId)}
+		c.chats[chatId] = w
+	}
+	return w
+}
+
+// chatLimit determines which limit to apply to a chat. Positive IDs are users; negative IDs and @usernames are groups
+// or channels.
+func (c *RateLimitedBotClient) chatLimit(chatId string) RateLimit {
+	id, err := strconv.ParseInt(chatId, 10, 64)
+	if err == nil && id > 0 {
+		return c.privateLimit
+	}
+	return c.groupLimit
+}
+
+// cleanup removes chats which have no recent requests, to avoid the chats map growing forever.
+// This runs at most once per minute.
+func (c *RateLimitedBotClient) cleanup(now time.Time) {
+	if now.Sub(c.lastCleanup) < time.Minute {
+		return
+	}
+	c.lastCleanup = now
+
+	for chatId, w := range c.chats {
+		// Calling nextAllowed drops any requests which have left the window.
+		if w.nextAllowed(now); len(w.sent) == 0 {
+			delete(c.chats, chatId)
+		}
+	}
+}
+
+func (l RateLimit) valid() bool {
+	return l.Requests > 0 && l.Per > 0
+}
+
+// slidingWindow keeps track of when the most recent requests were sent, to enforce a RateLimit.
+type slidingWindow struct {
+	limit RateLimit
+	// sent contains the times at which the most recent requests were sent, oldest first.
+	sent []time.Time
+}
+
+// nextAllowed returns the first time at which another request can be sent.
+func (w *slidingWindow) nextAllowed(now time.Time) time.Time {
+	// Drop any requests which have left the window.
+	idx := 0
+	for idx < len(w.sent) && !now.Before(w.sent[idx].Add(w.limit.Per)) {
+		idx++
+	}
+	w.sent = w.sent[idx:]
+
+	if len(w.sent) < w.limit.Requests {
+		return now
+	}
+	return w.sent[len(w.sent)-w.limit.Requests].Add(w.limit.Per)
+}
+
+// record marks a request as having been sent.
+func (w *slidingWindow) record(now time.Time) {
+	w.sent = append(w.sent, now)
+}
+
+// waitingRequest is a request waiting to be allowed through the RateLimitedBotClient.
+type waitingRequest struct {
+	// chatId is empty for requests which are only subject to the global limit.
+	chatId string
+	// ready is closed once the request is allowed to be sent.
+	ready chan struct{}
+	// granted is true once the ready channel has been closed.
+	granted bool
+}
+
+// requestLane contains all the waiting requests of the same priority, queued by chat.
+type requestLane struct {
+	// queues contains the FIFO queue of waiting requests for each chat.
+	queues map[string][]*waitingRequest
+	// order is the round-robin order in which chats are served.
+	order []string
+	// next is the index in order of the next chat to serve.
+	next int
+}
+
+func (l *requestLane) empty() bool {
+	return len(l.order) == 0
+}
+
+func (l *requestLane) push(w *waitingRequest) {
+	if l.queues == nil {
+		l.queues = map[string][]*waitingRequest{}
+	}
+
+	q, ok := l.queues[w.chatId]
+	if !ok {
+		l.order = append(l.order, w.chatId)
+	}
+	l.queues[w.chatId] = append(q, w)
+}
+
+// pop returns the first waiting request of the next ready chat, in round-robin order.
+func (l *requestLane) pop(ready func(chatId string) bool) *waitingRequest {
+	for i := 0; i < len(l.order); i++ {
+		idx := (l.next + i) % len(l.order)
+		chatId := l.order[idx]
+		if !ready(chatId) {
+			continue
+		}
+
+		q := l.queues[chatId]
+		w := q[0]
+		if len(q) == 1 {
+			l.removeChat(idx)
+		} else {
+			l.queues[chatId] = q[1:]
+			idx++
+		}
+
+		l.next = 0
+		if len(l.order) > 0 {
+			l.next = idx % len(l.order)
+		}
+		return w
+	}
+	return nil
+}
+
+// remove drops a request from the lane, if present.
+func (l *requestLane) remove(w *waitingRequest) {
+	q := l.queues[w.chatId]
+	for i, queued := range q {
+		if queued != w {
+			continue
+		}
+
+		if len(q) > 1 {
+			l.queues[w.chatId] = append(q[:i:i], q[i+1:]...)
+			return
+		}
+
+		for idx, chatId := range l.order {
+			if chatId == w.chatId {
+				l.removeChat(idx)
+				break
+			}
+		}
+		return
+	}
+}
+
+// removeChat removes the chat at the given index of the round-robin order.
+func (l *requestLane) removeChat(idx int) {
+	delete(l.queues, l.order[idx])
+	l.order = append(l.order[:idx:idx], l.order[idx+1:]...)
+	if l.next > idx {
+		l.next--
+	}
+	if l.next >= len(l.order) {
+		l.next = 0
+	}
+}
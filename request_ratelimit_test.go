@@ -0,0 +1,244 @@
+package gotgbot_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+)
+
+// countingBotClient is a BotClient which records the time at which each chat received a request.
+type countingBotClient struct {
+	gotgbot.BotClient
+
+	mu    sync.Mutex
+	calls map[string][]time.Time
+}
+
+func (c *countingBotClient) RequestWithContext(ctx context.Context, token string, method string, params map[string]string, data map[string]gotgbot.FileReader, opts *gotgbot.RequestOpts) (json.RawMessage, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.calls == nil {
+		c.calls = map[string][]time.Time{}
+	}
+	c.calls[params["chat_id"]] = append(c.calls[params["chat_id"]], time.Now())
+	return json.RawMessage(`true`), nil
+}
+
+func TestRateLimitedBotClientLimitsPerChat(t *testing.T) {
+	counter := &countingBotClient{}
+	c := gotgbot.NewRateLimitedBotClient(counter, &gotgbot.RateLimitedBotClientOpts{
+		PrivateChatLimit: gotgbot.RateLimit{Requests: 1, Per: time.Millisecond * 100},
+	})
+
+	start := time.Now()
+	wg := sync.WaitGroup{}
+	for i := 0; i < 3; i++ {
+		for _, chatId := range []string{"1", "2"} {
+			wg.Add(1)
+			go func(chatId string) {
+				defer wg.Done()
+				_, err := c.RequestWithContext(context.Background(), "token", "sendMessage", map[string]string{"chat_id": chatId}, nil, nil)
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}(chatId)
+		}
+	}
+	wg.Wait()
+
+	if d := time.Since(start); d < time.Millisecond*200 {
+		t.Errorf("expected 3 requests to one chat to take at least 200ms, took %s", d)
+	}
+
+	// Calls are timestamped once they reach the client, so allow for some scheduling jitter.
+	for chatId, calls := range counter.calls {
+		for i := 1; i < len(calls); i++ {
+			if gap := calls[i].Sub(calls[i-1]); gap < time.Millisecond*95 {
+				t.Errorf("chat %s received two requests %s apart", chatId, gap)
+			}
+		}
+	}
+}
+
+func TestRateLimitedBotClientServesChatsIndependently(t *testing.T) {
+	c := gotgbot.NewRateLimitedBotClient(&countingBotClient{}, &gotgbot.RateLimitedBotClientOpts{
+		PrivateChatLimit: gotgbot.RateLimit{Requests: 1, Per: time.Hour},
+	})
+
+	_, err := c.RequestWithContext(context.Background(), "token", "sendMessage", map[string]string{"chat_id": "1"}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error on first request: %v", err)
+	}
+
+	// Queue a request which can't be sent for an hour; it must not block other chats.
+	blockedCtx, cancelBlocked := context.WithCancel(context.Background())
+	defer cancelBlocked()
+	go func() {
+		_, _ = c.RequestWithContext(blockedCtx, "token", "sendMessage", map[string]string{"chat_id": "1"}, nil, nil)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	_, err = c.RequestWithContext(ctx, "token", "sendMessage", map[string]string{"chat_id": "2"}, nil, nil)
+	if err != nil {
+		t.Fatalf("expected another chat to be served while the first one waits, got: %v", err)
+	}
+}
+
+func TestRateLimitedBotClientLimitsRequestsWithoutChat(t *testing.T) {
+	c := gotgbot.NewRateLimitedBotClient(&countingBotClient{}, &gotgbot.RateLimitedBotClientOpts{
+		GlobalLimit: gotgbot.RateLimit{Requests: 1, Per: time.Hour},
+	})
+
+	_, err := c.RequestWithContext(context.Background(), "token", "getFile", map[string]string{"file_id": "a"}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error on first request: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	_, err = c.RequestWithContext(ctx, "token", "getFile", map[string]string{"file_id": "b"}, nil, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected requests without a chat to be globally limited, got: %v", err)
+	}
+
+	// Query answers and getUpdates are high priority by default, so skip the limits.
+	for _, method := range []string{"answerCallbackQuery", "getUpdates"} {
+		_, err = c.RequestWithContext(context.Background(), "token", method, nil, nil, nil)
+		if err != nil {
+			t.Fatalf("expected %s to skip the limits, got: %v", method, err)
+		}
+	}
+}
+
+func TestRateLimitedBotClientDoesNotLimitChatReads(t *testing.T) {
+	c := gotgbot.NewRateLimitedBotClient(&countingBotClient{}, &gotgbot.RateLimitedBotClientOpts{
+		PrivateChatLimit: gotgbot.RateLimit{Requests: 1, Per: time.Hour},
+	})
+
+	params := map[string]string{"chat_id": "1"}
+	_, err := c.RequestWithContext(context.Background(), "token", "sendMessage", params, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error on first request: %v", err)
+	}
+
+	// Reading the chat doesn't send anything to it, so only the global limit applies.
+	for _, method := range []string{"getChat", "getChatMember"} {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		_, err = c.RequestWithContext(ctx, "token", method, params, nil, nil)
+		cancel()
+		if err != nil {
+			t.Fatalf("expected %s to skip the per-chat limit, got: %v", method, err)
+		}
+	}
+}
+
+func TestRateLimitedBotClientHighPriority(t *testing.T) {
+	counter := &countingBotClient{}
+	c := gotgbot.NewRateLimitedBotClient(counter, &gotgbot.RateLimitedBotClientOpts{
+		GlobalLimit: gotgbot.RateLimit{Requests: 1, Per: time.Hour},
+		Priority: func(method string, params map[string]string) gotgbot.RequestPriority {
+			if method == "sendChatAction" {
+				return gotgbot.PriorityHigh
+			}
+			return gotgbot.PriorityNormal
+		},
+	})
+
+	_, err := c.RequestWithContext(context.Background(), "token", "sendMessage", map[string]string{"chat_id": "1"}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error on first request: %v", err)
+	}
+
+	// Both the Priority option and the request context can make a request high priority.
+	_, err = c.RequestWithContext(context.Background(), "token", "sendChatAction", map[string]string{"chat_id": "1"}, nil, nil)
+	if err != nil {
+		t.Fatalf("expected the Priority option to skip the limits, got: %v", err)
+	}
+	_, err = c.RequestWithContext(gotgbot.WithRequestPriority(context.Background(), gotgbot.PriorityHigh), "token", "sendMessage", map[string]string{"chat_id": "1"}, nil, nil)
+	if err != nil {
+		t.Fatalf("expected WithRequestPriority to skip the limits, got: %v", err)
+	}
+
+	// High priority requests aren't counted against the limits either, so the global limit still only has the
+	// first request.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	_, err = c.RequestWithContext(ctx, "token", "sendMessage", map[string]string{"chat_id": "2"}, nil, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected normal requests to still be limited, got: %v", err)
+	}
+	if n := len(counter.calls["1"]); n != 3 {
+		t.Errorf("expected 3 requests to chat 1, got %d", n)
+	}
+}
+
+func TestRateLimitedBotClientLowPriority(t *testing.T) {
+	counter := &countingBotClient{}
+	c := gotgbot.NewRateLimitedBotClient(counter, &gotgbot.RateLimitedBotClientOpts{
+		GlobalLimit: gotgbot.RateLimit{Requests: 1, Per: time.Millisecond * 200},
+	})
+
+	_, err := c.RequestWithContext(context.Background(), "token", "sendMessage", map[string]string{"chat_id": "0"}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error on first request: %v", err)
+	}
+
+	// Both requests are queued while the global limit is used up; the normal one must be sent first, even though the
+	// low priority one was queued earlier.
+	lowCtx := gotgbot.WithRequestPriority(context.Background(), gotgbot.PriorityLow)
+	wg := sync.WaitGroup{}
+	for _, r := range []struct {
+		ctx    context.Context
+		chatId string
+	}{{ctx: lowCtx, chatId: "1"}, {ctx: context.Background(), chatId: "2"}} {
+		wg.Add(1)
+		go func(ctx context.Context, chatId string) {
+			defer wg.Done()
+			_, err := c.RequestWithContext(ctx, "token", "sendMessage", map[string]string{"chat_id": chatId}, nil, nil)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}(r.ctx, r.chatId)
+	}
+	wg.Wait()
+
+	low, normal := counter.calls["1"], counter.calls["2"]
+	if len(low) != 1 || len(normal) != 1 {
+		t.Fatalf("expected one request per chat, got %d low and %d normal", len(low), len(normal))
+	}
+	if !normal[0].Before(low[0]) {
+		t.Errorf("expected the normal priority request to be sent before the low priority one")
+	}
+}
+
+func TestRateLimitedBotClientCancelsWaitingRequests(t *testing.T) {
+	c := gotgbot.NewRateLimitedBotClient(&countingBotClient{}, &gotgbot.RateLimitedBotClientOpts{
+		GroupChatLimit: gotgbot.RateLimit{Requests: 1, Per: time.Hour},
+	})
+
+	params := map[string]string{"chat_id": "-100123"}
+	_, err := c.RequestWithContext(context.Background(), "token", "sendMessage", params, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error on first request: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	_, err = c.RequestWithContext(ctx, "token", "sendMessage", params, nil, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected second request to time out while waiting, got: %v", err)
+	}
+
+	// High priority requests skip the queue entirely.
+	_, err = c.RequestWithContext(gotgbot.WithRequestPriority(context.Background(), gotgbot.PriorityHigh), "token", "sendMessage", params, nil, nil)
+	if err != nil {
+		t.Fatalf("expected high priority request to skip the queue, got: %v", err)
+	}
+}
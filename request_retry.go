@@ -0,0 +1,275 @@
+package gotgbot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the default number of times a request will be retried by the RetryBotClient.
+	DefaultMaxRetries = 3
+	// DefaultMinBackoff is the default delay before the first retry of a failed request.
+	DefaultMinBackoff = time.Millisecond * 500
+	// DefaultMaxBackoff is the default maximum delay between two retries of a failed request.
+	DefaultMaxBackoff = time.Second * 30
+)
+
+var _ BotClient = &RetryBotClient{}
+
+// RetryBotClient is a BotClient which wraps an existing BotClient to automatically retry failed requests.
+// It retries:
+//   - flood-wait errors (HTTP 429), waiting for the amount of time specified in the retry_after response parameter.
+//   - telegram server errors (HTTP 5xx), with jittered exponential backoff.
+//   - transient network errors, with jittered exponential backoff.
+//
+// Retries never outlive the context passed to RequestWithContext; if the next attempt would happen after the context
+// deadline, the last error is returned straight away.
+//
+// Note: network errors may happen after telegram has already received the request. Retrying non-idempotent methods
+// (eg, sendMessage) may therefore result in duplicate messages. Use ShouldRetry to customise this behaviour.
+//
+// Note: uploaded files must be sent again on every retry. Seekable files (such as *os.File) are rewound, but any other
+// reader is read fully into memory before the first attempt, even if no retry ends up being needed. Prefer seekable
+// readers for large uploads, or use the wrapped client directly.
+type RetryBotClient struct {
+	BotClient
+
+	// MaxRetries is the maximum number of times a request will be retried. 0 disables retries.
+	MaxRetries int
+	// MinBackoff is the delay before the first retry of a 5xx or network error. This value is doubled on every retry.
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum delay between two retries of a 5xx or network error.
+	MaxBackoff time.Duration
+	// MaxRetryAfter is the longest flood-wait the client is willing to wait for. If telegram asks us to wait longer,
+	// the TelegramError is returned instead. 0 means no limit, other than the request context.
+	MaxRetryAfter time.Duration
+	// ShouldRetry allows for overriding the default retry checks, as defined by IsRetryableError.
+	ShouldRetry func(method string, err error) bool
+}
+
+// RetryBotClientOpts declares all optional parameters for the NewRetryBotClient function.
+type RetryBotClientOpts struct {
+	// MaxRetries is the maximum number of times a request will be retried.
+	// If MaxRetries == 0, DefaultMaxRetries is used instead.
+	// If MaxRetries < 0, requests are never retried.
+	MaxRetries int
+	// MinBackoff is the delay before the first retry of a 5xx or network error. Defaults to DefaultMinBackoff.
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum delay between two retries of a 5xx or network error. Defaults to DefaultMaxBackoff.
+	MaxBackoff time.Duration
+	// MaxRetryAfter is the longest flood-wait the client is willing to wait for. 0 means no limit.
+	MaxRetryAfter time.Duration
+	// ShouldRetry allows for overriding the default retry checks, as defined by IsRetryableError.
+	ShouldRetry func(method string, err error) bool
+}
+
+// NewRetryBotClient wraps an existing BotClient to retry failed requests.
+// If the client is nil, a BaseBotClient is used.
+func NewRetryBotClient(client BotClient, opts *RetryBotClientOpts) *RetryBotClient {
+	c := &RetryBotClient{
+		BotClient:  defaultBotClient(client),
+		MaxRetries: DefaultMaxRetries,
+		MinBackoff: DefaultMinBackoff,
+		MaxBackoff: DefaultMaxBackoff,
+	}
+
+	if opts != nil {
+		if opts.MaxRetries > 0 {
+			c.MaxRetries = opts.MaxRetries
+		} else if opts.MaxRetries < 0 {
+			c.MaxRetries = 0
+		}
+		if opts.MinBackoff > 0 {
+			c.MinBackoff = opts.MinBackoff
+		}
+		if opts.MaxBackoff > 0 {
+			c.MaxBackoff = opts.MaxBackoff
+		}
+		c.MaxRetryAfter = opts.MaxRetryAfter
+		c.ShouldRetry = opts.ShouldRetry
+	}
+
+	return c
+}
+
+// RequestWithContext calls the wrapped BotClient, retrying the request if it fails with a retryable error.
+func (c *RetryBotClient) RequestWithContext(ctx context.Context, token string, method string, params map[string]string, data map[string]FileReader, opts *RequestOpts) (json.RawMessage, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if c.MaxRetries <= 0 {
+		return c.BotClient.RequestWithContext(ctx, token, method, params, data, opts)
+	}
+
+	files, err := newReplayableFiles(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare files for %s: %w", method, err)
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptData, err := files.replay()
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay files for %s: %w", method, err)
+		}
+
+		r, err := c.BotClient.RequestWithContext(ctx, token, method, params, attemptData, opts)
+		if err == nil || attempt >= c.MaxRetries || ctx.Err() != nil || !c.shouldRetry(method, err) {
+			return r, err
+		}
+
+		delay, ok := c.retryDelay(attempt, err)
+		if !ok {
+			return nil, err
+		}
+
+		// If the next attempt would happen after the deadline, there is no point in waiting for it.
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+			return nil, err
+		}
+
+		t := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil, err
+		case <-t.C:
+		}
+	}
+}
+
+func (c *RetryBotClient) shouldRetry(method string, err error) bool {
+	if c.ShouldRetry != nil {
+		return c.ShouldRetry(method, err)
+	}
+	return IsRetryableError(err)
+}
+
+// retryDelay determines how long to wait before the next attempt. Returns false if the request should not be retried.
+func (c *RetryBotClient) retryDelay(attempt int, err error) (time.Duration, bool) {
+	var tgErr *TelegramError
+	if errors.As(err, &tgErr) && tgErr.ResponseParams != nil && tgErr.ResponseParams.RetryAfter > 0 {
+		delay := time.Duration(tgErr.ResponseParams.RetryAfter) * time.Second
+		if c.MaxRetryAfter > 0 && delay > c.MaxRetryAfter {
+			return 0, false
+		}
+		return delay, true
+	}
+
+	delay := c.MinBackoff
+	for i := 0; i < attempt && delay < c.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if c.MaxBackoff > 0 && delay > c.MaxBackoff {
+		delay = c.MaxBackoff
+	}
+
+	// Apply "equal jitter"; wait somewhere between half and the full backoff, to avoid synchronised retries.
+	half := int64(delay / 2)
+	if half <= 0 {
+		return delay, true
+	}
+	return time.Duration(half + rand.Int63n(half+1)), true // nolint:gosec // jitter does not need a secure source.
+}
+
+// IsRetryableError returns true if the error returned by a BotClient is worth retrying. This is the case for:
+//   - flood-wait errors (HTTP 429) which contain a retry_after response parameter.
+//   - telegram server errors (HTTP 5xx).
+//   - network errors, such as timeouts or dropped connections.
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var tgErr *TelegramError
+	if errors.As(err, &tgErr) {
+		if tgErr.Code == http.StatusTooManyRequests {
+			return tgErr.ResponseParams != nil && tgErr.ResponseParams.RetryAfter > 0
+		}
+		return tgErr.Code >= http.StatusInternalServerError
+	}
+
+	if errors.Is(err, context.Canceled) {
+		// Cancellations are always intentional.
+		return false
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+}
+
+// replayableFiles allows for sending the same files multiple times, even though the underlying readers can only be
+// consumed once.
+type replayableFiles struct {
+	// files contains the original files to replay.
+	files map[string]FileReader
+	// seekers contains any seekable files, so they can be rewound.
+	seekers map[string]io.Seeker
+	// offsets contains the initial offset of any seekable files.
+	offsets map[string]int64
+	// contents contains the buffered contents of any non-seekable files.
+	contents map[string][]byte
+}
+
+// newReplayableFiles prepares the files to be sent multiple times.
+// Seekable files (such as *os.File) are rewound between attempts; other readers are buffered in memory.
+func newReplayableFiles(data map[string]FileReader) (*replayableFiles, error) {
+	rf := &replayableFiles{
+		files:    data,
+		seekers:  map[string]io.Seeker{},
+		offsets:  map[string]int64{},
+		contents: map[string][]byte{},
+	}
+
+	for k, f := range data {
+		if f.Data == nil {
+			continue
+		}
+
+		if s, ok := f.Data.(io.Seeker); ok {
+			offset, err := s.Seek(0, io.SeekCurrent)
+			if err == nil {
+				rf.seekers[k] = s
+				rf.offsets[k] = offset
+				continue
+			}
+			// Not all "seekers" can actually seek (eg, pipes); buffer those instead.
+		}
+
+		bs, err := io.ReadAll(f.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file contents of field %s: %w", k, err)
+		}
+		rf.contents[k] = bs
+	}
+
+	return rf, nil
+}
+
+// replay returns a new set of files, ready to be sent.
+func (rf *replayableFiles) replay() (map[string]FileReader, error) {
+	if len(rf.files) == 0 {
+		return rf.files, nil
+	}
+
+	out := make(map[string]FileReader, len(rf.files))
+	for k, f := range rf.files {
+		if s, ok := rf.seekers[k]; ok {
+			if _, err := s.Seek(rf.offsets[k], io.SeekStart); err != nil {
+				return nil, fmt.Errorf("failed to rewind file contents of field %s: %w", k, err)
+			}
+		} else if bs, ok := rf.contents[k]; ok {
+			f.Data = bytes.NewReader(bs)
+		}
+		out[k] = f
+	}
+	return out, nil
+}
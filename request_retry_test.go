@@ -0,0 +1,149 @@
+package gotgbot_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+)
+
+func TestRetryBotClientRetriesFloodWait(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"ok": false, "error_code": 429, "description": "Too Many Requests: retry after 1", "parameters": {"retry_after": 1}}`)
+			return
+		}
+		fmt.Fprint(w, `{"ok": true, "result": true}`)
+	}))
+	defer server.Close()
+
+	c := gotgbot.NewRetryBotClient(nil, nil)
+	start := time.Now()
+	_, err := c.RequestWithContext(context.Background(), "token", "sendMessage", nil, nil, &gotgbot.RequestOpts{APIURL: server.URL})
+	if err != nil {
+		t.Fatalf("expected request to succeed after retrying, got: %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("expected 2 calls, got %d", calls.Load())
+	}
+	if time.Since(start) < time.Second {
+		t.Errorf("expected client to wait for retry_after before retrying")
+	}
+}
+
+func TestRetryBotClientRespectsContextDeadline(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"ok": false, "error_code": 429, "description": "Too Many Requests: retry after 30", "parameters": {"retry_after": 30}}`)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	c := gotgbot.NewRetryBotClient(nil, nil)
+	_, err := c.RequestWithContext(ctx, "token", "sendMessage", nil, nil, &gotgbot.RequestOpts{APIURL: server.URL})
+
+	var tgErr *gotgbot.TelegramError
+	if !errors.As(err, &tgErr) || tgErr.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected flood-wait error to be returned, got: %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected a single call, since retry_after exceeds the deadline; got %d", calls.Load())
+	}
+}
+
+func TestRetryBotClientDoesNotRetryClientErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"ok": false, "error_code": 400, "description": "Bad Request: chat not found"}`)
+	}))
+	defer server.Close()
+
+	c := gotgbot.NewRetryBotClient(nil, nil)
+	_, err := c.RequestWithContext(context.Background(), "token", "sendMessage", nil, nil, &gotgbot.RequestOpts{APIURL: server.URL})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected a single call, got %d", calls.Load())
+	}
+}
+
+func TestRetryBotClientReplaysFiles(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := calls.Add(1)
+
+		_, files, err := parseMultipart(r)
+		if err != nil {
+			t.Errorf("failed to parse multipart body on call %d: %v", call, err)
+		}
+		if files["document"] != "file contents" {
+			t.Errorf("unexpected file contents on call %d: %q", call, files["document"])
+		}
+
+		if call == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			fmt.Fprint(w, "<html>502 Bad Gateway</html>")
+			return
+		}
+		fmt.Fprint(w, `{"ok": true, "result": true}`)
+	}))
+	defer server.Close()
+
+	c := gotgbot.NewRetryBotClient(nil, &gotgbot.RetryBotClientOpts{MinBackoff: time.Millisecond})
+	data := map[string]gotgbot.FileReader{
+		// Wrap the reader to hide the Seek method; this ensures the file gets buffered.
+		"document": {Name: "file.txt", Data: io.MultiReader(strings.NewReader("file contents"))},
+	}
+	_, err := c.RequestWithContext(context.Background(), "token", "sendDocument", map[string]string{"chat_id": "1"}, data, &gotgbot.RequestOpts{APIURL: server.URL})
+	if err != nil {
+		t.Fatalf("expected request to succeed after retrying, got: %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("expected 2 calls, got %d", calls.Load())
+	}
+}
+
+func parseMultipart(r *http.Request) (map[string]string, map[string]string, error) {
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fields := map[string]string{}
+	files := map[string]string{}
+	for {
+		p, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			return fields, files, nil
+		} else if err != nil {
+			return nil, nil, err
+		}
+
+		bs, err := io.ReadAll(p)
+		if err != nil {
+			return nil, nil, err
+		}
+		if p.FileName() != "" {
+			files[p.FormName()] = string(bs)
+		} else {
+			fields[p.FormName()] = string(bs)
+		}
+	}
+}
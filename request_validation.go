@@ -0,0 +1,51 @@
+package gotgbot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+var _ BotClient = &ValidatingBotClient{}
+
+// ValidatingBotClient is a BotClient which wraps an existing BotClient to check requests against the constraints
+// described in the Bot API documentation (text lengths, numeric ranges, allowed values...) before sending them.
+// Invalid requests are never sent; a *ValidationError is returned instead.
+//
+// Values which are sent as interfaces (eg, InputMedia or InlineQueryResult) can't be decoded from the request
+// parameters, and are left for telegram to check. Their Validate method can be called directly instead.
+//
+// The allowed values of fields are only checked for values which are never sent by telegram, such as parse modes.
+// Types which telegram also sends (eg, MessageEntity) gain new values over time, so those are left for telegram to check.
+type ValidatingBotClient struct {
+	BotClient
+}
+
+// NewValidatingBotClient wraps an existing BotClient to validate requests before sending them.
+// If the client is nil, a BaseBotClient is used.
+func NewValidatingBotClient(client BotClient) *ValidatingBotClient {
+	return &ValidatingBotClient{BotClient: defaultBotClient(client)}
+}
+
+func (c *ValidatingBotClient) RequestWithContext(ctx context.Context, token string, method string, params map[string]string, data map[string]FileReader, opts *RequestOpts) (json.RawMessage, error) {
+	if err := ValidateRequest(method, params); err != nil {
+		return nil, err
+	}
+	return c.BotClient.RequestWithContext(ctx, token, method, params, data, opts)
+}
+
+// ValidateRequest checks the parameters of a request against the constraints described in the Bot API
+// documentation. Requests to unknown methods are not checked.
+func ValidateRequest(method string, params map[string]string) error {
+	validate, ok := requestValidators[method]
+	if !ok {
+		return nil
+	}
+
+	err := validate(params)
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		validationErr.Method = method
+	}
+	return err
+}
@@ -0,0 +1,147 @@
+package gotgbot_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+)
+
+// recordingBotClient is a BotClient which records the methods it was called with.
+type recordingBotClient struct {
+	gotgbot.BotClient
+
+	methods []string
+}
+
+func (c *recordingBotClient) RequestWithContext(ctx context.Context, token string, method string, params map[string]string, data map[string]gotgbot.FileReader, opts *gotgbot.RequestOpts) (json.RawMessage, error) {
+	c.methods = append(c.methods, method)
+	return json.RawMessage(`{}`), nil
+}
+
+func TestValidate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		value interface{ Validate() error }
+		field string
+	}{
+		"empty inline keyboard button": {
+			value: gotgbot.InlineKeyboardButton{Text: "button"},
+			field: "",
+		},
+		"inline keyboard button with two actions": {
+			value: gotgbot.InlineKeyboardButton{Text: "button", Url: "https://example.com", CallbackData: "data"},
+			field: "",
+		},
+		"callback data too long": {
+			value: gotgbot.InlineKeyboardButton{Text: "button", CallbackData: strings.Repeat("a", 70)},
+			field: "callback_data",
+		},
+		"nested button": {
+			value: gotgbot.InlineKeyboardMarkup{InlineKeyboard: [][]gotgbot.InlineKeyboardButton{
+				{{Text: "ok", CallbackData: "ok"}},
+				{{Text: "ok", CallbackData: "ok"}, {Text: "empty"}},
+			}},
+			field: "inline_keyboard[1][1]",
+		},
+		"invalid parse mode": {
+			value: &gotgbot.SendMessageOpts{ParseMode: "html"},
+			field: "parse_mode",
+		},
+		"caption too long": {
+			value: &gotgbot.SendPhotoOpts{Caption: strings.Repeat("a", 1025)},
+			field: "caption",
+		},
+		"out of range": {
+			value: &gotgbot.GetUpdatesOpts{Limit: 101},
+			field: "limit",
+		},
+		"invalid enum": {
+			value: &gotgbot.SendPollOpts{Type: "not_a_poll"},
+			field: "type",
+		},
+	} {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.value.Validate()
+
+			var validationErr *gotgbot.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("expected a validation error, got: %v", err)
+			}
+			if validationErr.Field != tc.field {
+				t.Errorf("expected error on field %q, got %q", tc.field, validationErr.Field)
+			}
+		})
+	}
+}
+
+func TestValidateValidValues(t *testing.T) {
+	for name, value := range map[string]interface{ Validate() error }{
+		"inline keyboard button": gotgbot.InlineKeyboardButton{Text: "button", CallbackData: strings.Repeat("a", 64)},
+		"nil opts":               (*gotgbot.SendMessageOpts)(nil),
+		"empty opts":             &gotgbot.SendMessageOpts{},
+		"parse mode":             &gotgbot.SendMessageOpts{ParseMode: gotgbot.ParseModeMarkdownV2},
+	} {
+		value := value
+		t.Run(name, func(t *testing.T) {
+			if err := value.Validate(); err != nil {
+				t.Errorf("expected value to be valid, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidatingBotClient(t *testing.T) {
+	recorder := &recordingBotClient{}
+	b := &gotgbot.Bot{
+		Token:     "token",
+		BotClient: gotgbot.NewValidatingBotClient(recorder),
+	}
+
+	_, err := b.SendMessage(1, strings.Repeat("a", 5000), nil)
+
+	var validationErr *gotgbot.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected a validation error, got: %v", err)
+	}
+	if validationErr.Method != "sendMessage" || validationErr.Field != "text" {
+		t.Errorf("unexpected validation error: %v", validationErr)
+	}
+	if len(recorder.methods) != 0 {
+		t.Errorf("expected invalid request not to be sent, got %v", recorder.methods)
+	}
+
+	_, err = b.SendMessage(1, "text", &gotgbot.SendMessageOpts{
+		ReplyMarkup: gotgbot.InlineKeyboardMarkup{InlineKeyboard: [][]gotgbot.InlineKeyboardButton{{{Text: "empty"}}}},
+	})
+	if !errors.As(err, &validationErr) || validationErr.Field != "reply_markup.inline_keyboard[0][0]" {
+		t.Errorf("expected a validation error on the reply markup button, got: %v", err)
+	}
+	if len(recorder.methods) != 0 {
+		t.Errorf("expected invalid request not to be sent, got %v", recorder.methods)
+	}
+
+	// Formatting isn't counted in the message length.
+	text := strings.Repeat("<b>a</b>", 1000)
+	_, err = b.SendMessage(1, text, &gotgbot.SendMessageOpts{ParseMode: gotgbot.ParseModeHTML})
+	if err != nil {
+		t.Fatalf("expected formatted message to be valid, got: %v", err)
+	}
+	if len(recorder.methods) != 1 || recorder.methods[0] != "sendMessage" {
+		t.Errorf("expected valid request to be sent, got %v", recorder.methods)
+	}
+
+	// Telegram adds new values to the types it also sends, such as entity types; these aren't rejected.
+	_, err = b.SendMessage(1, "text", &gotgbot.SendMessageOpts{
+		Entities: []gotgbot.MessageEntity{{Type: "new_entity_type", Offset: 0, Length: 4}},
+	})
+	if err != nil {
+		t.Fatalf("expected unknown entity type to be valid, got: %v", err)
+	}
+	if len(recorder.methods) != 2 {
+		t.Errorf("expected request with unknown entity type to be sent, got %v", recorder.methods)
+	}
+}
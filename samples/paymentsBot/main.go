@@ -74,7 +74,7 @@ func main() {
 
 // start introduces the bot and sends an initial invoice (in Telegram stars; denoted as XTR).
 func start(b *gotgbot.Bot, ctx *ext.Context) error {
-	if ctx.EffectiveChat.Type != "private" {
+	if ctx.EffectiveChat.Type != gotgbot.ChatTypePrivate {
 		// Only reply in private chats.
 		return nil
 	}
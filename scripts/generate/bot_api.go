@@ -0,0 +1,157 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// generateBotAPI generates the BotAPI interface, which lists all the generated Bot methods, as well as a configurable
+// mock implementation of it in the gotgbottest package.
+func generateBotAPI(d APIDescription) error {
+	iface := strings.Builder{}
+	iface.WriteString(`
+// THIS FILE IS AUTOGENERATED. DO NOT EDIT.
+// Regen by running 'go generate' in the repo root.
+
+package gotgbot
+
+import (
+	"context"
+)
+
+// BotAPI lists all the telegram Bot API methods available on the Bot. Code which depends on BotAPI rather than on the
+// Bot directly can use a mock implementation (such as gotgbottest.MockBot) in tests.
+type BotAPI interface {`)
+
+	mock := strings.Builder{}
+	mock.WriteString(`
+// THIS FILE IS AUTOGENERATED. DO NOT EDIT.
+// Regen by running 'go generate' in the repo root.
+
+package gotgbottest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+)
+
+// MockBot is a configurable implementation of gotgbot.BotAPI. Each method calls the matching Func field (eg, both
+// SendMessage and SendMessageWithContext call SendMessageFunc); methods without a Func return ErrNotMocked.
+type MockBot struct {`)
+
+	mockMethods := strings.Builder{}
+
+	for _, tgMethodName := range orderedMethods(d) {
+		tgMethod := d.Methods[tgMethodName]
+
+		sig, err := getMethodSignature(d, tgMethod)
+		if err != nil {
+			return fmt.Errorf("failed to get method signature of %s: %w", tgMethodName, err)
+		}
+
+		iface.WriteString(fmt.Sprintf("\n%s(%s) (%s)", sig.name, strings.Join(sig.args, ", "), strings.Join(append(sig.retTypes, "error"), ", ")))
+		iface.WriteString(fmt.Sprintf("\n%sWithContext(ctx context.Context, %s) (%s)", sig.name, strings.Join(sig.args, ", "), strings.Join(append(sig.retTypes, "error"), ", ")))
+
+		mock.WriteString(sig.mockField())
+		mockMethods.WriteString(sig.mockMethods())
+	}
+
+	iface.WriteString("\n}")
+	iface.WriteString("\n\nvar _ BotAPI = &Bot{}\n")
+
+	mock.WriteString("\n}")
+	mock.WriteString("\n\nvar _ gotgbot.BotAPI = &MockBot{}\n")
+	mock.WriteString(mockMethods.String())
+
+	if err := writeGenToFile(iface, "gen_bot_api.go"); err != nil {
+		return err
+	}
+	return writeGenToFile(mock, "gotgbottest/gen_mock_bot.go")
+}
+
+// methodSignature describes the go signature of a generated Bot method.
+type methodSignature struct {
+	name        string
+	args        []string
+	argNames    []string
+	retTypes    []string
+	defaultRets []string
+}
+
+func getMethodSignature(d APIDescription, tgMethod MethodDescription) (methodSignature, error) {
+	req := tgMethod.getRequiredFields()
+	args, err := req.getFunctionArgs(d)
+	if err != nil {
+		return methodSignature{}, fmt.Errorf("failed to get required arguments: %w", err)
+	}
+
+	retTypes, err := tgMethod.GetReturnTypes(d)
+	if err != nil {
+		return methodSignature{}, fmt.Errorf("failed to get return types: %w", err)
+	}
+
+	return methodSignature{
+		name:        strings.Title(tgMethod.Name),
+		args:        args,
+		argNames:    req.getNames(),
+		retTypes:    retTypes,
+		defaultRets: getDefaultReturnVals(d, retTypes),
+	}, nil
+}
+
+func (sig methodSignature) mockField() string {
+	return fmt.Sprintf("\n// %sFunc is called by MockBot.%s and MockBot.%sWithContext.\n%sFunc func(%s) (%s)",
+		sig.name, sig.name, sig.name, sig.name, strings.Join(sig.qualifiedArgs(), ", "), strings.Join(sig.qualifiedRetTypes(), ", "))
+}
+
+func (sig methodSignature) mockMethods() string {
+	args := strings.Join(sig.qualifiedArgs()[1:], ", ")
+	argNames := strings.Join(sig.argNames, ", ")
+	rets := strings.Join(sig.qualifiedRetTypes(), ", ")
+
+	return fmt.Sprintf(`
+// %[1]s calls MockBot.%[1]sFunc with a background context.
+func (m *MockBot) %[1]s(%[2]s) (%[4]s) {
+	return m.%[1]sWithContext(context.Background(), %[3]s)
+}
+
+// %[1]sWithContext calls MockBot.%[1]sFunc.
+func (m *MockBot) %[1]sWithContext(ctx context.Context, %[2]s) (%[4]s) {
+	if m.%[1]sFunc == nil {
+		return %[5]s
+	}
+	return m.%[1]sFunc(ctx, %[3]s)
+}
+`, sig.name, args, argNames, rets, strings.Join(append(sig.defaultRets, fmt.Sprintf("fmt.Errorf(\"%%w: %s\", ErrNotMocked)", sig.name)), ", "))
+}
+
+// qualifiedArgs returns the method arguments as seen from the gotgbottest package, including the context.
+func (sig methodSignature) qualifiedArgs() []string {
+	args := []string{"ctx context.Context"}
+	for _, arg := range sig.args {
+		split := strings.SplitN(arg, " ", 2)
+		args = append(args, split[0]+" "+qualifyType(split[1], "gotgbot"))
+	}
+	return args
+}
+
+// qualifiedRetTypes returns the method return types as seen from the gotgbottest package, including the error.
+func (sig methodSignature) qualifiedRetTypes() []string {
+	var retTypes []string
+	for _, retType := range sig.retTypes {
+		retTypes = append(retTypes, qualifyType(retType, "gotgbot"))
+	}
+	return append(retTypes, "error")
+}
+
+// qualifyType prefixes exported types with the given package name, keeping any pointer or slice prefixes.
+func qualifyType(goType string, pkg string) string {
+	base := strings.TrimLeft(goType, "*[]")
+	if base == "" || !unicode.IsUpper(rune(base[0])) {
+		return goType
+	}
+	return goType[:len(goType)-len(base)] + pkg + "." + base
+}
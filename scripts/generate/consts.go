@@ -3,11 +3,10 @@ package main
 import (
 	"errors"
 	"fmt"
-	"regexp"
 	"strings"
 )
 
-func generateConsts(d APIDescription) error {
+func generateConsts(d APIDescription, enums []enumType) error {
 	consts := strings.Builder{}
 	consts.WriteString(`
 // THIS FILE IS AUTOGENERATED. DO NOT EDIT.
@@ -25,23 +24,7 @@ package gotgbot
 
 	consts.WriteString(generateParseModeConsts())
 
-	chatActions, err := generateChatActionConsts(d)
-	if err != nil {
-		return fmt.Errorf("failed to generate consts for chat actions: %w", err)
-	}
-	consts.WriteString(chatActions)
-
-	stickerTypeConsts, err := generateTypeConsts(d, "Sticker")
-	if err != nil {
-		return fmt.Errorf("failed to generate consts for sticker types: %w", err)
-	}
-	consts.WriteString(stickerTypeConsts)
-
-	chatTypeConsts, err := generateTypeConsts(d, "Chat")
-	if err != nil {
-		return fmt.Errorf("failed to generate consts for chat types: %w", err)
-	}
-	consts.WriteString(chatTypeConsts)
+	consts.WriteString(generateEnumConsts(enums))
 
 	return writeGenToFile(consts, "gen_consts.go")
 }
@@ -84,31 +67,6 @@ func generateUpdateTypeConsts(d APIDescription) (string, error) {
 	return out.String(), nil
 }
 
-func generateTypeConsts(d APIDescription, typeName string) (string, error) {
-	updType, ok := d.Types[typeName]
-	if !ok {
-		return "", errors.New("missing '" + typeName + "' type data")
-	}
-	out := strings.Builder{}
-	out.WriteString("\n// The consts listed below represent all the " + strings.ToLower(typeName) + " types that can be obtained from telegram.")
-	out.WriteString("\nconst (")
-	for _, f := range updType.Fields {
-		if f.Name != "type" {
-			// the field we want to look at is called "type", ignore all others.
-			continue
-		}
-		types, err := extractQuotedValues(f.Description)
-		if err != nil {
-			return "", fmt.Errorf("failed to get quoted types: %w", err)
-		}
-		for _, t := range types {
-			out.WriteString(writeConst(typeName+"Type"+snakeToTitle(t), t))
-		}
-	}
-	out.WriteString(")\n\n")
-	return out.String(), nil
-}
-
 func generateParseModeConsts() string {
 	// Adding these manually because they're not part of the spec, and theyre not going to change much anyway.
 	formattingTypes := []string{"HTML", "MarkdownV2", "Markdown", "None"}
@@ -129,53 +87,6 @@ func generateParseModeConsts() string {
 	return out.String()
 }
 
-func generateChatActionConsts(d APIDescription) (string, error) {
-	methodName := "sendChatAction"
-	fieldName := "action"
-
-	sendChatActionMethod, ok := d.Methods[methodName]
-	if !ok {
-		return "", errors.New("missing '" + methodName + "' method data")
-	}
-
-	var description string
-
-	for _, field := range sendChatActionMethod.Fields {
-		if field.Name == fieldName {
-			description = field.Description
-
-			break
-		}
-	}
-
-	if description == "" {
-		return "", errors.New("missing '" + fieldName + "' method field")
-	}
-
-	// Parse chat action from the description
-	var chatActions []string
-
-	re := regexp.MustCompile(`(?P<action>[a-z_]+) f?or`)
-	results := re.FindAllStringSubmatch(description, -1)
-
-	for _, result := range results {
-		chatActions = append(chatActions, result[1])
-	}
-
-	out := strings.Builder{}
-	out.WriteString("\n// The consts listed below represent all the chat action options that can be sent to telegram.")
-	out.WriteString("\nconst (")
-
-	for _, a := range chatActions {
-		constName := "ChatAction" + snakeToTitle(a)
-		out.WriteString(writeConst(constName, a))
-	}
-
-	out.WriteString(")\n\n")
-
-	return out.String(), nil
-}
-
 func writeConst(name string, value string) string {
 	return fmt.Sprintf("\n%s = \"%s\"", name, value)
 }
@@ -0,0 +1,279 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// enumType is a named string type, generated for the fields which can only contain a set of values.
+type enumType struct {
+	// name is the go name of the type; eg, ChatType.
+	name string
+	// source describes where the values come from; eg, Chat.type.
+	source string
+	// values contains the known values, in the order in which they are documented.
+	values []string
+	// fieldName is the name of the field which defined the type.
+	fieldName string
+	// constantField is set for the constant fields of interface types, which identify each subtype.
+	constantField bool
+}
+
+// enumField is a field which uses an enum type, from either a type or a method.
+type enumField struct {
+	owner string
+	field string
+}
+
+// getEnumTypes finds all the enumerated fields of the spec, and sets their EnumType. Fields with the same values (or a
+// subset of the values of a field with the same name) share the same type; for example, Chat.type and ChatFullInfo.type
+// are both ChatType.
+func getEnumTypes(d APIDescription) ([]enumType, error) {
+	var enums []enumType
+	fields := map[string][]enumField{}
+
+	// The constant fields of interface types are enumerated by the subtypes.
+	for _, tgTypeName := range orderedTgTypes(d) {
+		tgType := d.Types[tgTypeName]
+		if len(tgType.Subtypes) == 0 {
+			continue
+		}
+
+		constantField, err := tgType.getConstantFieldFromParent(d)
+		if err != nil || constantField == "" {
+			continue
+		}
+
+		enum := enumType{
+			name:          tgType.Name + snakeToTitle(constantField),
+			source:        tgType.Name + "." + constantField,
+			fieldName:     constantField,
+			constantField: true,
+		}
+		for _, subTypeName := range tgType.Subtypes {
+			value := d.Types[subTypeName].getTypeNameFromParent(tgType.Name)
+			if !contains(value, enum.values) {
+				enum.values = append(enum.values, value)
+			}
+			fields[enum.name] = append(fields[enum.name], enumField{owner: subTypeName, field: constantField})
+		}
+		enums = append(enums, enum)
+	}
+
+	// Other string fields list their allowed values in their description.
+	type candidate struct {
+		enumField
+		enumType
+	}
+	var candidates []candidate
+	for _, tgTypeName := range orderedTgTypes(d) {
+		tgType := d.Types[tgTypeName]
+		for _, f := range tgType.Fields {
+			if f.isConstantField(d, tgType) {
+				continue
+			}
+			if values := getStringEnumValues(d, f); values != nil {
+				candidates = append(candidates, candidate{
+					enumField: enumField{owner: tgType.Name, field: f.Name},
+					enumType: enumType{
+						name:      tgType.Name + snakeToTitle(f.Name),
+						source:    tgType.Name + "." + f.Name,
+						values:    values,
+						fieldName: f.Name,
+					},
+				})
+			}
+		}
+	}
+
+	for _, tgMethodName := range orderedMethods(d) {
+		tgMethod := d.Methods[tgMethodName]
+		for _, f := range tgMethod.Fields {
+			if values := getStringEnumValues(d, f); values != nil {
+				candidates = append(candidates, candidate{
+					enumField: enumField{owner: tgMethod.Name, field: f.Name},
+					enumType: enumType{
+						name:      methodEnumName(tgMethod.Name, f.Name),
+						source:    tgMethod.Name + "." + f.Name,
+						values:    values,
+						fieldName: f.Name,
+					},
+				})
+			}
+		}
+	}
+
+	// Handle the largest sets first, so that subsets can reuse them.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return len(candidates[i].values) > len(candidates[j].values)
+	})
+
+	for _, c := range candidates {
+		name := c.name
+		if existing := findMatchingEnum(enums, c.enumType); existing != "" {
+			name = existing
+		} else {
+			enums = append(enums, c.enumType)
+		}
+		fields[name] = append(fields[name], c.enumField)
+	}
+
+	sort.Slice(enums, func(i, j int) bool {
+		return enums[i].name < enums[j].name
+	})
+
+	for _, enum := range enums {
+		if isTgType(d, enum.name) {
+			return nil, fmt.Errorf("enum type %s for %s clashes with an existing type", enum.name, enum.source)
+		}
+		for _, f := range fields[enum.name] {
+			setEnumType(d, f, enum.name)
+		}
+	}
+
+	// The fields shared by all the subtypes of an interface need the same type, even when only some subtypes list the
+	// allowed values (eg, PassportElementErrorUnspecified.type).
+	for _, tgTypeName := range orderedTgTypes(d) {
+		tgType := d.Types[tgTypeName]
+		if len(tgType.Subtypes) == 0 {
+			continue
+		}
+
+		subTypes, err := getTypesByName(d, tgType.Subtypes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get subtypes of %s: %w", tgType.Name, err)
+		}
+
+		for _, commonField := range getCommonFields(subTypes) {
+			enumName := getSharedEnumType(subTypes, commonField.Name)
+			if enumName == "" {
+				continue
+			}
+			for _, subType := range subTypes {
+				setEnumType(d, enumField{owner: subType.Name, field: commonField.Name}, enumName)
+			}
+		}
+	}
+
+	return enums, nil
+}
+
+// getSharedEnumType returns the enum type used by the given field in any of the types.
+func getSharedEnumType(types []TypeDescription, fieldName string) string {
+	for _, t := range types {
+		for _, f := range t.Fields {
+			if f.Name == fieldName && f.EnumType != "" {
+				return f.EnumType
+			}
+		}
+	}
+	return ""
+}
+
+// getStringEnumValues returns the allowed values of a string field, if any.
+func getStringEnumValues(d APIDescription, f Field) []string {
+	goType, err := f.getPreferredType(d)
+	if err != nil || strings.TrimPrefix(goType, "*") != "string" {
+		return nil
+	}
+	return getEnumValues(f.Description)
+}
+
+// methodEnumName names the enum type of a method field, after the method's subject; eg, the action of sendChatAction is
+// a ChatAction.
+func methodEnumName(tgMethodName string, fieldName string) string {
+	subject := strings.TrimLeftFunc(tgMethodName, unicode.IsLower)
+	if strings.HasSuffix(subject, snakeToTitle(fieldName)) {
+		return subject
+	}
+	return subject + snakeToTitle(fieldName)
+}
+
+// findMatchingEnum returns the name of the existing enum type which can be used for the given values, if any.
+func findMatchingEnum(enums []enumType, enum enumType) string {
+	for _, existing := range enums {
+		if existing.constantField {
+			continue
+		}
+
+		if len(existing.values) == len(enum.values) && isSubset(enum.values, existing.values) {
+			return existing.name
+		}
+
+		if existing.fieldName == enum.fieldName && isSubset(enum.values, existing.values) {
+			return existing.name
+		}
+	}
+	return ""
+}
+
+func isSubset(values []string, of []string) bool {
+	for _, v := range values {
+		if !contains(v, of) {
+			return false
+		}
+	}
+	return true
+}
+
+func setEnumType(d APIDescription, ef enumField, enumName string) {
+	if tgType, ok := d.Types[ef.owner]; ok {
+		for idx := range tgType.Fields {
+			if tgType.Fields[idx].Name == ef.field {
+				tgType.Fields[idx].EnumType = enumName
+			}
+		}
+		return
+	}
+
+	tgMethod := d.Methods[ef.owner]
+	for idx := range tgMethod.Fields {
+		if tgMethod.Fields[idx].Name == ef.field {
+			tgMethod.Fields[idx].EnumType = enumName
+		}
+	}
+}
+
+// underlyingType returns the go type of the field, with any enum type replaced by its underlying string type.
+func (f Field) underlyingType(goType string) string {
+	if f.EnumType == "" {
+		return goType
+	}
+	return strings.Replace(goType, f.EnumType, "string", 1)
+}
+
+func generateEnumConsts(enums []enumType) string {
+	out := strings.Builder{}
+	for _, enum := range enums {
+		var constNames []string
+		for _, v := range enum.values {
+			constNames = append(constNames, enum.name+snakeToTitle(v))
+		}
+
+		out.WriteString(fmt.Sprintf("\n// %s represents the values of the %s field.", enum.name, enum.source))
+		out.WriteString(fmt.Sprintf("\ntype %s string\n", enum.name))
+
+		out.WriteString(fmt.Sprintf("\n// The consts listed below represent all the %s values known to this version of the library.", enum.name))
+		out.WriteString("\nconst (")
+		for idx, v := range enum.values {
+			out.WriteString(fmt.Sprintf("\n%s %s = \"%s\"", constNames[idx], enum.name, v))
+		}
+		out.WriteString("\n)\n")
+
+		out.WriteString(fmt.Sprintf(`
+// IsKnown returns true if the %[1]s is one of the values known to this version of the library.
+// Values added to the Bot API after this version was generated return false.
+func (v %[1]s) IsKnown() bool {
+	switch v {
+	case %[2]s:
+		return true
+	default:
+		return false
+	}
+}
+`, enum.name, strings.Join(constNames, ", ")))
+	}
+	return out.String()
+}
@@ -143,7 +143,7 @@ func getConstantFieldFromCommons(d APIDescription, common []Field) (string, erro
 			return "", err
 		}
 		// These may be better as hand-picked fields by name (type/status/source) rather than type
-		if s == "string" {
+		if v.underlyingType(s) == "string" {
 			return v.Name, nil
 		}
 	}
@@ -188,6 +188,9 @@ type Field struct {
 	Types       []string `json:"types"`
 	Required    bool     `json:"required"`
 	Description string   `json:"description"`
+
+	// EnumType is the named string type used for enumerated fields, if any. It is set by getEnumTypes.
+	EnumType string `json:"-"`
 }
 
 var usernameDocsMatcher = regexp.MustCompile(` +(or username.*)?\(.+ @[a-z]+\)`)
@@ -232,9 +235,26 @@ const (
 	typeInputString       = "InputString"
 )
 
-func generate(d APIDescription) error {
+// generateOpts contains the options which change the generated code.
+type generateOpts struct {
+	// ExtraFieldTypes contains the types which should keep track of unknown JSON fields.
+	ExtraFieldTypes map[string]bool
+}
+
+func generate(d APIDescription, opts generateOpts) error {
+	for name := range opts.ExtraFieldTypes {
+		if _, ok := d.Types[name]; !ok {
+			return fmt.Errorf("unknown type %s requested for extra fields", name)
+		}
+	}
+
+	enums, err := getEnumTypes(d)
+	if err != nil {
+		return fmt.Errorf("failed to get enum types: %w", err)
+	}
+
 	// TODO: Use golang templates instead of string builders
-	if err := generateTypes(d); err != nil {
+	if err := generateTypes(d, opts); err != nil {
 		return fmt.Errorf("failed to generate types: %w", err)
 	}
 
@@ -242,11 +262,19 @@ func generate(d APIDescription) error {
 		return fmt.Errorf("failed to generate helpers: %w", err)
 	}
 
+	if err := generateBotAPI(d); err != nil {
+		return fmt.Errorf("failed to generate bot API: %w", err)
+	}
+
+	if err := generateValidation(d); err != nil {
+		return fmt.Errorf("failed to generate validation: %w", err)
+	}
+
 	if err := generateHelpers(d); err != nil {
 		return fmt.Errorf("failed to generate helpers: %w", err)
 	}
 
-	if err := generateConsts(d); err != nil {
+	if err := generateConsts(d, enums); err != nil {
 		return fmt.Errorf("failed to generate consts: %w", err)
 	}
 
@@ -321,6 +349,16 @@ func isTgStructType(d APIDescription, goType string) bool {
 }
 
 func (f Field) getPreferredType(d APIDescription) (string, error) {
+	goType, err := f.getSpecType(d)
+	if err != nil || f.EnumType == "" {
+		return goType, err
+	}
+	// Enumerated fields use their named type instead of a plain string.
+	return strings.Replace(goType, "string", f.EnumType, 1), nil
+}
+
+// getSpecType returns the go type matching the types listed in the spec.
+func (f Field) getSpecType(d APIDescription) (string, error) {
 	if f.Name == "media" {
 		if len(f.Types) == 1 && f.Types[0] == "String" {
 			return typeInputFileOrString, nil
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"flag"
 	"fmt"
 	"net/http"
 	"os"
@@ -18,6 +19,11 @@ const (
 )
 
 func main() {
+	// Types listed in -extra-fields keep track of any JSON fields which aren't in the spec, so they aren't lost when
+	// telegram adds new fields.
+	extraFields := flag.String("extra-fields", "", "comma-separated list of types which should keep unknown JSON fields")
+	flag.Parse()
+
 	// If GOTGBOT_UPGRADE set, get latest commit and generate from that.
 	// If GOTGBOT_FROM_FILE is set, read from file instead of HTTP (allows offline dev)
 	apiSpec, err := getAPISpec(os.Getenv("GOTGBOT_UPGRADE") != "", os.Getenv("GOTGBOT_FROM_FILE"))
@@ -25,12 +31,25 @@ func main() {
 		panic(fmt.Errorf("failed to get API spec: %w", err))
 	}
 
-	err = generate(apiSpec)
+	err = generate(apiSpec, generateOpts{
+		ExtraFieldTypes: parseTypeList(*extraFields),
+	})
 	if err != nil {
 		panic(fmt.Errorf("failed to generate telegram bot api library from latest API spec: %w", err))
 	}
 }
 
+// parseTypeList parses a comma-separated list of type names into a set.
+func parseTypeList(list string) map[string]bool {
+	types := map[string]bool{}
+	for _, t := range strings.Split(list, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			types[t] = true
+		}
+	}
+	return types
+}
+
 func getCommit(upgrade bool) (string, error) {
 	if upgrade {
 		commit, err := updatePinnedCommit()
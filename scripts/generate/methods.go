@@ -244,6 +244,10 @@ func generateValue(d APIDescription, methodName string, f Field, goParam string,
 	}
 
 	stringer := goTypeStringer(fieldType)
+	if f.EnumType != "" {
+		// Enum types are sent as their underlying string.
+		stringer = "string(" + goTypeStringer(f.underlyingType(fieldType)) + ")"
+	}
 	if stringer != "" {
 		if !f.Required {
 			// Ints and Floats should generally not be sent if they're 0.
@@ -274,7 +278,7 @@ if %s != %s {
 				return fmt.Sprintf(`
 if %s != nil {
 	v["%s"] = %s
-}`, goParam, f.Name, fmt.Sprintf(goTypeStringer(fieldType), goParam)), false, nil
+}`, goParam, f.Name, fmt.Sprintf(stringer, goParam)), false, nil
 			}
 		}
 
@@ -14,9 +14,10 @@ var (
 	customMarshalTmpl         = template.Must(template.New("customMarshal").Parse(customMarshal))
 	customUnmarshalTmpl       = template.Must(template.New("customUnmarshal").Parse(customUnmarshal))
 	customStructUnmarshalTmpl = template.Must(template.New("customStructUnmarshal").Parse(customStructUnmarshal))
+	extraFieldsTmpl           = template.Must(template.New("extraFields").Parse(extraFields))
 )
 
-func generateTypes(d APIDescription) error {
+func generateTypes(d APIDescription, opts generateOpts) error {
 	file := strings.Builder{}
 	file.WriteString(`
 // THIS FILE IS AUTOGENERATED. DO NOT EDIT.
@@ -41,7 +42,7 @@ import (
 	for _, tgTypeName := range orderedTgTypes(d) {
 		tgType := d.Types[tgTypeName]
 
-		typeDef, err := generateTypeDef(d, tgType)
+		typeDef, err := generateTypeDef(d, tgType, opts.ExtraFieldTypes[tgTypeName])
 		if err != nil {
 			return fmt.Errorf("failed to generate type definition of %s: %w", tgTypeName, err)
 		}
@@ -52,17 +53,38 @@ import (
 	return writeGenToFile(file, "gen_types.go")
 }
 
-func generateTypeDef(d APIDescription, tgType TypeDescription) (string, error) {
+func generateTypeDef(d APIDescription, tgType TypeDescription, extraFields bool) (string, error) {
 	// If interface type, generate interface sections
 	if len(tgType.Subtypes) != 0 || tgType.Name == tgTypeInputFile {
+		if extraFields {
+			return "", fmt.Errorf("extra fields are not supported for interface type %s", tgType.Name)
+		}
 		return generateParentType(d, tgType)
 	}
 
+	if extraFields {
+		for _, parentTypeName := range tgType.SubtypeOf {
+			constantField, err := d.Types[parentTypeName].getConstantFieldFromParent(d)
+			if err != nil {
+				return "", fmt.Errorf("failed to get constant field from %s: %w", parentTypeName, err)
+			}
+			if constantField != "" {
+				// These subtypes already have a custom marshaller to set their constant field.
+				return "", fmt.Errorf("extra fields are not supported for subtype %s of %s", tgType.Name, parentTypeName)
+			}
+		}
+	}
+
 	typeFields, err := generateTypeFields(d, tgType)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate type fields for %s: %w", tgType.Name, err)
 	}
 
+	if extraFields {
+		typeFields += "\n// extras contains the fields sent by telegram which aren't known to this version of the library, by JSON name."
+		typeFields += "\nextras map[string]json.RawMessage"
+	}
+
 	typeDef := strings.Builder{}
 	typeDef.WriteString(tgType.docs())
 
@@ -74,12 +96,24 @@ func generateTypeDef(d APIDescription, tgType TypeDescription) (string, error) {
 		typeDef.WriteString("\n}")
 	}
 
-	customUnmarshalDef, err := setupCustomUnmarshal(d, tgType)
+	customUnmarshalDef, err := setupCustomUnmarshal(d, tgType, extraFields)
 	if err != nil {
 		return "", fmt.Errorf("failed to setup custom unmarshal for %s: %w", tgType.Name, err)
 	}
 	typeDef.WriteString(customUnmarshalDef)
 
+	if extraFields {
+		err = extraFieldsTmpl.Execute(&typeDef, extraFieldsData{
+			Type:            tgType.Name,
+			FieldsVar:       titleToCamelCase(tgType.Name) + "JSONFields",
+			FieldNames:      getFieldNames(tgType.Fields),
+			CustomUnmarshal: customUnmarshalDef != "",
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to generate extra fields methods for %s: %w", tgType.Name, err)
+		}
+	}
+
 	interfaces, err := fulfilParentTypeInterfaces(d, tgType)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate parent type interfaces %s: %w", tgType.Name, err)
@@ -210,7 +244,7 @@ func generateParentType(d APIDescription, tgType TypeDescription) (string, error
 
 // Incoming types which marshal into interfaces need special handling to make sure the interfaces are
 // populated correctly.
-func setupCustomUnmarshal(d APIDescription, tgType TypeDescription) (string, error) {
+func setupCustomUnmarshal(d APIDescription, tgType TypeDescription, extraFields bool) (string, error) {
 	var fields []customUnmarshalFieldData
 	generateCustomMarshal := false
 	for idx, f := range tgType.Fields {
@@ -262,8 +296,10 @@ func setupCustomUnmarshal(d APIDescription, tgType TypeDescription) (string, err
 
 	bd := strings.Builder{}
 	err := customUnmarshalTmpl.Execute(&bd, customUnmarshalData{
-		Type:   tgType.Name,
-		Fields: fields,
+		Type:        tgType.Name,
+		Fields:      fields,
+		ExtraFields: extraFields,
+		FieldsVar:   titleToCamelCase(tgType.Name) + "JSONFields",
 	})
 	if err != nil {
 		return "", fmt.Errorf("failed to generate custom unmarshal: %w", err)
@@ -329,12 +365,39 @@ func interfaceUnmarshalFunc(d APIDescription, tgType TypeDescription) (string, e
 		})
 	}
 
+	subTypes, err := getTypesByName(d, tgType.Subtypes)
+	if err != nil {
+		return "", fmt.Errorf("failed to get subtypes by name for %s: %w", tgType.Name, err)
+	}
+
+	// The unknown type needs to implement the same common getters as all the other subtypes.
+	var commonFields []customStructUnmarshalCommonFieldData
+	constantFieldType := "string"
+	for _, f := range getCommonFields(subTypes) {
+		prefType, err := f.getPreferredType(d)
+		if err != nil {
+			return "", fmt.Errorf("failed to get preferred type for field %s of %s: %w", f.Name, tgType.Name, err)
+		}
+		if f.Name == constantField {
+			constantFieldType = prefType
+		}
+		commonFields = append(commonFields, customStructUnmarshalCommonFieldData{
+			Name:     snakeToTitle(f.Name),
+			Type:     prefType,
+			Constant: f.Name == constantField,
+		})
+	}
+
 	bd := strings.Builder{}
 	err = customStructUnmarshalTmpl.Execute(&bd, customStructUnmarshalData{
-		UnmarshalFuncName: "unmarshal" + tgType.Name,
-		ParentType:        tgType.Name,
-		ConstantFieldName: snakeToTitle(constantField),
-		CaseStatements:    cases,
+		UnmarshalFuncName:     "unmarshal" + tgType.Name,
+		ParentType:            tgType.Name,
+		ParentTypeMethod:      titleToCamelCase(tgType.Name),
+		ConstantFieldName:     snakeToTitle(constantField),
+		ConstantFieldType:     constantFieldType,
+		ConstantJSONFieldName: constantField,
+		CaseStatements:        cases,
+		CommonFields:          commonFields,
 	})
 	if err != nil {
 		return "", fmt.Errorf("failed to generate interface unmarshaller: %w", err)
@@ -638,8 +701,10 @@ type customUnmarshalFieldData struct {
 }
 
 type customUnmarshalData struct {
-	Type   string
-	Fields []customUnmarshalFieldData
+	Type        string
+	Fields      []customUnmarshalFieldData
+	ExtraFields bool
+	FieldsVar   string
 }
 
 const customUnmarshal = `
@@ -666,16 +731,75 @@ func (v *{{.Type}}) UnmarshalJSON(b []byte) error {
 			v.{{ $f.Name }} = t.{{ $f.Name }}
 		{{- end }}
 	{{- end }}
+	{{- if .ExtraFields }}
+
+	v.extras, err = unmarshalExtraFields(b, {{.FieldsVar}})
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal {{.Type}} extra fields: %w", err)
+	}
+	{{- end }}
 
 	return nil
 }
 `
 
+type extraFieldsData struct {
+	Type            string
+	FieldsVar       string
+	FieldNames      []string
+	CustomUnmarshal bool
+}
+
+const extraFields = `
+// {{.FieldsVar}} contains the JSON names of all the fields of {{.Type}} which are known to this version of the library.
+var {{.FieldsVar}} = map[string]bool{
+	{{- range $f := .FieldNames }}
+	"{{ $f }}": true,
+	{{- end }}
+}
+{{ if not .CustomUnmarshal }}
+// UnmarshalJSON is a custom JSON unmarshaller to keep track of the fields which aren't known to this version of the library.
+func (v *{{.Type}}) UnmarshalJSON(b []byte) error {
+	type alias {{.Type}}
+	err := json.Unmarshal(b, (*alias)(v))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal {{.Type}} JSON: %w", err)
+	}
+
+	v.extras, err = unmarshalExtraFields(b, {{.FieldsVar}})
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal {{.Type}} extra fields: %w", err)
+	}
+	return nil
+}
+{{ end }}
+// MarshalJSON is a custom JSON marshaller to include the fields which aren't known to this version of the library.
+func (v {{.Type}}) MarshalJSON() ([]byte, error) {
+	type alias {{.Type}}
+	b, err := json.Marshal((alias)(v))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal {{.Type}} JSON: %w", err)
+	}
+	return marshalExtraFields(b, v.extras)
+}
+
+// ExtraField returns the raw JSON value of a field which isn't known to this version of the library, by its JSON name.
+// This allows for reading fields which telegram recently added, before the library is updated.
+func (v {{.Type}}) ExtraField(name string) (json.RawMessage, bool) {
+	f, ok := v.extras[name]
+	return f, ok
+}
+`
+
 type customStructUnmarshalData struct {
-	UnmarshalFuncName string
-	ParentType        string
-	ConstantFieldName string
-	CaseStatements    []customStructUnmarshalCaseData
+	UnmarshalFuncName     string
+	ParentType            string
+	ParentTypeMethod      string
+	ConstantFieldName     string
+	ConstantFieldType     string
+	ConstantJSONFieldName string
+	CaseStatements        []customStructUnmarshalCaseData
+	CommonFields          []customStructUnmarshalCommonFieldData
 }
 
 type customStructUnmarshalCaseData struct {
@@ -683,6 +807,12 @@ type customStructUnmarshalCaseData struct {
 	TypeName           string
 }
 
+type customStructUnmarshalCommonFieldData struct {
+	Name     string
+	Type     string
+	Constant bool
+}
+
 const customStructUnmarshal = `
 // {{.UnmarshalFuncName}}Array is a JSON unmarshalling helper which allows unmarshalling an array of interfaces 
 // using {{.UnmarshalFuncName}}.
@@ -717,7 +847,7 @@ func {{.UnmarshalFuncName}}(d json.RawMessage) ({{.ParentType}}, error) {
 		}
 
 		t := struct {
-			{{.ConstantFieldName}} string
+			{{.ConstantFieldName}} {{.ConstantFieldType}}
 		}{}
 		err := json.Unmarshal(d, &t)
 		if err != nil {
@@ -735,7 +865,52 @@ func {{.UnmarshalFuncName}}(d json.RawMessage) ({{.ParentType}}, error) {
 			return s, nil
 		{{ end }}
 		}
-		return nil, fmt.Errorf("unknown interface for {{.ParentType}} with {{.ConstantFieldName}} %v", t.{{.ConstantFieldName}})
+		return Unknown{{.ParentType}}{
+			{{.ConstantFieldName}}: t.{{.ConstantFieldName}},
+			Raw: d,
+		}, nil
+}
+
+// Unknown{{.ParentType}} is a {{.ParentType}} with a {{.ConstantFieldName}} which isn't known to this version of the library; for
+// example, when telegram adds a new {{.ParentType}} type. The raw JSON data is kept, so that it can still be inspected and
+// marshalled back to JSON.
+type Unknown{{.ParentType}} struct {
+	// {{.ConstantFieldName}} is the {{.ConstantJSONFieldName}} of the {{.ParentType}}, as sent by telegram.
+	{{.ConstantFieldName}} {{.ConstantFieldType}}
+	// Raw contains the raw JSON data of the {{.ParentType}}.
+	Raw json.RawMessage
+}
+
+// Ensure that the unknown type correctly implements the parent interface.
+var _ {{.ParentType}} = Unknown{{.ParentType}}{}
+{{ range $f := .CommonFields }}
+// Get{{ $f.Name }} is a helper method to easily access the common fields of an interface.
+func (v Unknown{{ $.ParentType }}) Get{{ $f.Name }}() {{ $f.Type }} {
+	{{- if $f.Constant }}
+	return v.{{ $f.Name }}
+	{{- else }}
+	return v.Merge{{ $.ParentType }}().Get{{ $f.Name }}()
+	{{- end }}
+}
+{{ end }}
+// Merge{{.ParentType}} returns a Merged{{.ParentType}} struct containing all the known fields of the {{.ParentType}}.
+// Fields which can't be decoded are left empty.
+func (v Unknown{{.ParentType}}) Merge{{.ParentType}}() Merged{{.ParentType}} {
+	m := Merged{{.ParentType}}{}
+	_ = json.Unmarshal(v.Raw, &m)
+	m.{{.ConstantFieldName}} = v.{{.ConstantFieldName}}
+	return m
+}
+
+// Unknown{{.ParentType}}.{{.ParentTypeMethod}} is a dummy method to avoid interface implementation.
+func (v Unknown{{.ParentType}}) {{.ParentTypeMethod}}() {}
+
+// MarshalJSON returns the raw JSON data of the {{.ParentType}}, so that it can be marshalled back without losing any fields.
+func (v Unknown{{.ParentType}}) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
 }`
 
 type customMarshalData struct {
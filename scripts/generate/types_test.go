@@ -0,0 +1,105 @@
+package main
+
+import (
+	"flag"
+	"go/format"
+	"os"
+	"strings"
+	"testing"
+)
+
+var updateFixtures = flag.Bool("update", false, "update the generated test fixtures")
+
+// extraFieldsFixturePath is the checked-in fixture generated with the extra fields mode, which is compiled and tested
+// as part of the library's tests.
+const extraFieldsFixturePath = "../../gen_extra_fields_fixture_test.go"
+
+// extraFieldsFixtureAPI describes the fixture types; one with simple fields, and one which needs a custom unmarshaller
+// for its interface field. The interface field reuses MessageOrigin, so that the fixture compiles against the library.
+var extraFieldsFixtureAPI = APIDescription{
+	Types: map[string]TypeDescription{
+		"ExtraFieldsFixture": {
+			Name:        "ExtraFieldsFixture",
+			Description: []string{"This object is a test fixture for types which keep track of unknown JSON fields."},
+			Href:        "https://core.telegram.org/bots/api",
+			Fields: []Field{
+				{Name: "message_id", Types: []string{tgTypeInteger}, Required: true, Description: "Message identifier"},
+				{Name: "text", Types: []string{tgTypeString}, Description: "Optional. Message text"},
+			},
+		},
+		"ExtraFieldsOriginFixture": {
+			Name:        "ExtraFieldsOriginFixture",
+			Description: []string{"This object is a test fixture for types with interface fields which keep track of unknown JSON fields."},
+			Href:        "https://core.telegram.org/bots/api",
+			Fields: []Field{
+				{Name: "message_id", Types: []string{tgTypeInteger}, Required: true, Description: "Message identifier"},
+				{Name: "origin", Types: []string{"MessageOrigin"}, Required: true, Description: "Origin of the message"},
+			},
+		},
+		"MessageOrigin": {
+			Name:     "MessageOrigin",
+			Subtypes: []string{"MessageOriginUser", "MessageOriginHiddenUser"},
+		},
+		"MessageOriginUser": {
+			Name:      "MessageOriginUser",
+			SubtypeOf: []string{"MessageOrigin"},
+			Fields: []Field{
+				{Name: "type", Types: []string{tgTypeString}, Required: true, Description: "Type of the message origin, always \"user\""},
+				{Name: "date", Types: []string{tgTypeInteger}, Required: true, Description: "Date the message was sent originally in Unix time"},
+			},
+		},
+		"MessageOriginHiddenUser": {
+			Name:      "MessageOriginHiddenUser",
+			SubtypeOf: []string{"MessageOrigin"},
+			Fields: []Field{
+				{Name: "type", Types: []string{tgTypeString}, Required: true, Description: "Type of the message origin, always \"hidden_user\""},
+				{Name: "date", Types: []string{tgTypeInteger}, Required: true, Description: "Date the message was sent originally in Unix time"},
+			},
+		},
+	},
+}
+
+// TestExtraFieldsFixture checks that the checked-in extra fields fixture matches the generator's output, such that the
+// library's tests cover the code generated in this mode. Run with -update to regenerate it.
+func TestExtraFieldsFixture(t *testing.T) {
+	file := strings.Builder{}
+	file.WriteString(`
+// THIS FILE IS AUTOGENERATED. DO NOT EDIT.
+// Regen by running 'go test ./scripts/generate -update' in the repo root.
+
+package gotgbot
+
+import (
+	"encoding/json"
+	"fmt"
+)
+`)
+
+	for _, name := range []string{"ExtraFieldsFixture", "ExtraFieldsOriginFixture"} {
+		typeDef, err := generateTypeDef(extraFieldsFixtureAPI, extraFieldsFixtureAPI.Types[name], true)
+		if err != nil {
+			t.Fatalf("failed to generate type definition of %s: %v", name, err)
+		}
+		file.WriteString(typeDef)
+	}
+
+	generated, err := format.Source([]byte(file.String()))
+	if err != nil {
+		t.Fatalf("failed to format generated fixture: %v\n%s", err, file.String())
+	}
+
+	if *updateFixtures {
+		if err := os.WriteFile(extraFieldsFixturePath, generated, 0o600); err != nil {
+			t.Fatalf("failed to update fixture: %v", err)
+		}
+		return
+	}
+
+	fixture, err := os.ReadFile(extraFieldsFixturePath)
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	if string(fixture) != string(generated) {
+		t.Errorf("fixture %s is out of date; run 'go test ./scripts/generate -update' to regenerate it. Expected:\n%s", extraFieldsFixturePath, generated)
+	}
+}
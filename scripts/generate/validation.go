@@ -0,0 +1,666 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// lengthConstraintRegex matches string length limits, such as "1-4096 characters" or "1-64 bytes".
+	lengthConstraintRegex = regexp.MustCompile(`(?i)(\d+)-(\d+) (characters|bytes)`)
+	// numberConstraintRegex matches numeric limits, such as "; 0-1500" or "Values between 1-100 are accepted".
+	numberConstraintRegex = regexp.MustCompile(`(?:^|[;,] |Values between )(\d+)-(\d+)(?:$|\.| are accepted| \(Only)`)
+	// betweenConstraintRegex matches numeric limits, such as "Must be between 1 and 360 if specified".
+	betweenConstraintRegex = regexp.MustCompile(`Must be between (\d+) and (\d+)`)
+	// countConstraintRegex matches list length limits, such as "list of 1-100 identifiers" or "must include 2-10 items".
+	countConstraintRegex = regexp.MustCompile(`(?i)list of (\d+)-(\d+)|include (\d+)-(\d+) items`)
+	// requiredUnlessRegex matches fields which are only required when other fields are missing.
+	requiredUnlessRegex = regexp.MustCompile(`Required if ([a-z_]+)(?: and ([a-z_]+))? (?:is|are) not specified`)
+	// enumStartRegex matches the start of a list of allowed values, such as: can be either "private", "group"...
+	enumStartRegex = regexp.MustCompile(`(?i)\b(?:can be(?: either)?|one of|pass|type,)\s+"[a-z_]+"`)
+	enumValueRegex = regexp.MustCompile(`"([a-z_]+)"`)
+	// chooseOneRegex matches lists of unquoted values, such as the sendChatAction actions.
+	chooseOneRegex      = regexp.MustCompile(`Choose one, [^:]*: (.*)`)
+	chooseOneValueRegex = regexp.MustCompile(`(?:^|, | or )([a-z_]+)`)
+)
+
+// getEnumValues returns the list of allowed values from a field description, if there is one.
+func getEnumValues(description string) []string {
+	var values []string
+	if loc := enumStartRegex.FindStringIndex(description); loc != nil {
+		rest := description[loc[0]:]
+		if end := strings.Index(rest, ". "); end >= 0 {
+			rest = rest[:end]
+		}
+
+		for _, m := range enumValueRegex.FindAllStringSubmatch(rest, -1) {
+			if !contains(m[1], values) {
+				values = append(values, m[1])
+			}
+		}
+	} else if m := chooseOneRegex.FindStringSubmatch(description); m != nil {
+		for _, v := range chooseOneValueRegex.FindAllStringSubmatch(m[1], -1) {
+			if !contains(v[1], values) {
+				values = append(values, v[1])
+			}
+		}
+	}
+
+	if len(values) < 2 {
+		return nil
+	}
+	return values
+}
+
+// rangeConstraint is an inclusive range of allowed values.
+type rangeConstraint struct {
+	min string
+	max string
+}
+
+// fieldConstraints contains all the checks which apply to a single field.
+type fieldConstraints struct {
+	// required is set for fields which must not be empty.
+	required bool
+	// requiredUnless contains the fields which make this field optional, when specified.
+	requiredUnless []string
+	// length is the allowed string length.
+	length *rangeConstraint
+	// lengthInBytes is set when the length is counted in bytes, rather than characters.
+	lengthInBytes bool
+	// parseModeField is the field containing the parse mode to apply before counting characters, if any.
+	parseModeField string
+	// enum contains the go expressions of the allowed values.
+	enum []string
+	// number is the allowed range of numeric values.
+	number *rangeConstraint
+	// count is the allowed number of list items.
+	count *rangeConstraint
+	// nested is set for fields containing objects which can be validated themselves.
+	nested bool
+}
+
+func (c fieldConstraints) hasValueChecks() bool {
+	return c.length != nil || c.enum != nil || c.number != nil || c.count != nil || c.nested
+}
+
+func (c fieldConstraints) isEmpty() bool {
+	return !c.required && c.requiredUnless == nil && !c.hasValueChecks()
+}
+
+// validationGenerator generates the Validate methods for all the types and method options sent to telegram.
+type validationGenerator struct {
+	d APIDescription
+	// requestTypes contains all the types which can be sent as part of a method call.
+	requestTypes map[string]bool
+	// needsValidation caches whether a type, or any of its children, has any constraints.
+	needsValidation map[string]bool
+}
+
+func generateValidation(d APIDescription) error {
+	file := strings.Builder{}
+	file.WriteString(`
+// THIS FILE IS AUTOGENERATED. DO NOT EDIT.
+// Regen by running 'go generate' in the repo root.
+
+package gotgbot
+`)
+
+	g := validationGenerator{
+		d:               d,
+		requestTypes:    getRequestTypes(d),
+		needsValidation: map[string]bool{},
+	}
+
+	for _, tgTypeName := range orderedTgTypes(d) {
+		tgType := d.Types[tgTypeName]
+		if !g.requestTypes[tgTypeName] || len(tgType.Subtypes) != 0 || !g.typeNeedsValidation(tgTypeName, map[string]bool{}) {
+			continue
+		}
+
+		validate, err := g.generateTypeValidate(tgType)
+		if err != nil {
+			return fmt.Errorf("failed to generate validation for type %s: %w", tgTypeName, err)
+		}
+		file.WriteString(validate)
+	}
+
+	var validatedMethods []string
+	for _, tgMethodName := range orderedMethods(d) {
+		tgMethod := d.Methods[tgMethodName]
+
+		validate, err := g.generateOptsValidate(tgMethod)
+		if err != nil {
+			return fmt.Errorf("failed to generate validation for method %s opts: %w", tgMethodName, err)
+		}
+		file.WriteString(validate)
+
+		validateParams, err := g.generateParamsValidate(tgMethod)
+		if err != nil {
+			return fmt.Errorf("failed to generate validation for method %s params: %w", tgMethodName, err)
+		}
+		if validateParams != "" {
+			file.WriteString(validateParams)
+			validatedMethods = append(validatedMethods, tgMethodName)
+		}
+	}
+
+	file.WriteString("\n// requestValidators contains the parameter checks of all methods which have any.")
+	file.WriteString("\nvar requestValidators = map[string]func(params map[string]string) error{")
+	for _, tgMethodName := range validatedMethods {
+		file.WriteString(fmt.Sprintf("\n%q: %s,", tgMethodName, paramsValidatorName(tgMethodName)))
+	}
+	file.WriteString("\n}\n")
+
+	return writeGenToFile(file, "gen_validation.go")
+}
+
+// getRequestTypes returns all the types which can be sent to telegram as part of a method call.
+func getRequestTypes(d APIDescription) map[string]bool {
+	types := map[string]bool{}
+
+	var add func(name string)
+	add = func(name string) {
+		for isTgArray(name) {
+			name = strings.TrimPrefix(name, "Array of ")
+		}
+
+		t, ok := d.Types[name]
+		if !ok || types[name] {
+			return
+		}
+		types[name] = true
+
+		for _, subtype := range t.Subtypes {
+			add(subtype)
+		}
+		for _, f := range t.Fields {
+			for _, fieldType := range f.Types {
+				add(fieldType)
+			}
+		}
+	}
+
+	for _, m := range d.Methods {
+		for _, f := range m.Fields {
+			for _, fieldType := range f.Types {
+				add(fieldType)
+			}
+		}
+	}
+	return types
+}
+
+// typeNeedsValidation checks whether a type, or any of the types it contains, has any constraints.
+func (g validationGenerator) typeNeedsValidation(name string, visiting map[string]bool) bool {
+	if needed, ok := g.needsValidation[name]; ok {
+		return needed
+	}
+	if visiting[name] {
+		return false
+	}
+	visiting[name] = true
+
+	var subtypes []string
+	if name == typeReplyMarkup {
+		for _, t := range getReplyMarkupTypes(g.d) {
+			subtypes = append(subtypes, t.Name)
+		}
+	} else if t, ok := g.d.Types[name]; ok {
+		subtypes = t.Subtypes
+	} else {
+		return false
+	}
+
+	needed := false
+	if len(subtypes) != 0 {
+		for _, subtype := range subtypes {
+			needed = needed || g.typeNeedsValidation(subtype, visiting)
+		}
+	} else {
+		tgType := g.d.Types[name]
+		sentByAPI := tgType.sentByAPI(g.d)
+		needed = getOneOfCheck(tgType) != ""
+		for _, f := range tgType.Fields {
+			if f.isConstantField(g.d, tgType) {
+				continue
+			}
+
+			fieldType, err := structFieldType(g.d, f)
+			if err != nil {
+				continue
+			}
+			if g.getFieldConstraints(f, fieldType, tgType.Fields, !sentByAPI, sentByAPI, visiting).isEmpty() {
+				continue
+			}
+			needed = true
+		}
+	}
+
+	g.needsValidation[name] = needed
+	return needed
+}
+
+// getOneOfCheck returns the name of the check to apply when a type requires some of its optional fields to be set.
+func getOneOfCheck(tgType TypeDescription) string {
+	description := strings.Join(tgType.Description, " ")
+	if strings.Contains(description, "xactly one of the optional fields") {
+		return "checkExactlyOne"
+	}
+	if strings.Contains(description, "t most one of the optional fields") {
+		return "checkAtMostOne"
+	}
+	return ""
+}
+
+// structFieldType returns the go type of a field in a generated struct.
+func structFieldType(d APIDescription, f Field) (string, error) {
+	fieldType, err := f.getPreferredType(d)
+	if err != nil {
+		return "", err
+	}
+
+	if isTgStructType(d, fieldType) && !f.Required {
+		fieldType = "*" + fieldType
+	}
+	return fieldType, nil
+}
+
+// getFieldConstraints derives the constraints of a field from its description. Fields which must not be empty are
+// only checked if checkRequired is set; types which are also sent by telegram are often sent back with only some of
+// their fields set (eg, users in text_mention entities).
+// The allowed values of fields are not checked for types which are also sent by telegram (eg, MessageEntity.Type), as
+// telegram adds new values to those over time; checking them would reject valid values which this version of the
+// library doesn't know about yet.
+func (g validationGenerator) getFieldConstraints(f Field, goType string, siblings []Field, checkRequired bool, sentByAPI bool, visiting map[string]bool) fieldConstraints {
+	var c fieldConstraints
+	description := f.GetDescription()
+	baseType := strings.TrimPrefix(f.underlyingType(goType), "*")
+
+	switch baseType {
+	case "string":
+		if m := lengthConstraintRegex.FindStringSubmatch(description); m != nil {
+			c.length = &rangeConstraint{min: m[1], max: m[2]}
+			c.lengthInBytes = strings.EqualFold(m[3], "bytes")
+			if !c.lengthInBytes {
+				c.parseModeField = getParseModeField(f, siblings)
+			}
+		}
+
+		if f.Name == "parse_mode" || strings.HasSuffix(f.Name, "_parse_mode") {
+			c.enum = []string{"ParseModeHTML", "ParseModeMarkdownV2", "ParseModeMarkdown"}
+		} else if !sentByAPI {
+			for _, v := range getEnumValues(description) {
+				c.enum = append(c.enum, fmt.Sprintf("%q", v))
+			}
+		}
+
+		c.required = checkRequired && f.Required && c.length == nil && c.enum == nil && !strings.Contains(strings.ToLower(description), "empty")
+
+	case "int64", "float64":
+		if m := betweenConstraintRegex.FindStringSubmatch(description); m != nil {
+			c.number = &rangeConstraint{min: m[1], max: m[2]}
+		} else if m := numberConstraintRegex.FindStringSubmatch(description); m != nil {
+			c.number = &rangeConstraint{min: m[1], max: m[2]}
+		}
+
+	default:
+		if isArray(goType) {
+			if m := countConstraintRegex.FindStringSubmatch(description); m != nil {
+				if m[1] != "" {
+					c.count = &rangeConstraint{min: m[1], max: m[2]}
+				} else {
+					c.count = &rangeConstraint{min: m[3], max: m[4]}
+				}
+			}
+		}
+
+		elemType := stripPointersAndArrays(goType)
+		c.nested = (g.requestTypes[elemType] || elemType == typeReplyMarkup) && g.typeNeedsValidation(elemType, visiting)
+		c.required = checkRequired && f.Required && isInterfaceType(g.d, goType)
+	}
+
+	if m := requiredUnlessRegex.FindStringSubmatch(description); m != nil {
+		for _, name := range m[1:] {
+			if name != "" {
+				c.requiredUnless = append(c.requiredUnless, name)
+			}
+		}
+	}
+
+	return c
+}
+
+// getParseModeField returns the name of the field defining how to parse the entities of a text field, if any.
+func getParseModeField(f Field, siblings []Field) string {
+	for _, name := range []string{f.Name + "_parse_mode", "parse_mode"} {
+		for _, sibling := range siblings {
+			if sibling.Name == name {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+func isInterfaceType(d APIDescription, goType string) bool {
+	switch goType {
+	case typeReplyMarkup, tgTypeInputFile, typeInputFileOrString:
+		return true
+	}
+
+	t, ok := d.Types[goType]
+	return ok && len(t.Subtypes) != 0
+}
+
+// isSetCondition returns the go condition which checks that a field has been set.
+func isSetCondition(d APIDescription, expr string, goType string) string {
+	switch {
+	case isPointer(goType) || isInterfaceType(d, goType):
+		return expr + " != nil"
+	case isArray(goType):
+		return "len(" + expr + ") != 0"
+	}
+
+	switch goType {
+	case "string":
+		return expr + ` != ""`
+	case "int64", "float64":
+		return expr + " != 0"
+	case "bool":
+		return expr
+	default:
+		// Structs are always set.
+		return ""
+	}
+}
+
+// validatedField is a field to validate, along with its go expression.
+type validatedField struct {
+	Field
+	goType string
+	expr   string
+	c      fieldConstraints
+}
+
+func (g validationGenerator) generateTypeValidate(tgType TypeDescription) (string, error) {
+	sentByAPI := tgType.sentByAPI(g.d)
+
+	var fields []validatedField
+	for _, f := range tgType.Fields {
+		if f.isConstantField(g.d, tgType) {
+			continue
+		}
+
+		fieldType, err := structFieldType(g.d, f)
+		if err != nil {
+			return "", fmt.Errorf("failed to get field type of %s: %w", f.Name, err)
+		}
+
+		fields = append(fields, validatedField{
+			Field:  f,
+			goType: fieldType,
+			expr:   "v." + snakeToTitle(f.Name),
+			c:      g.getFieldConstraints(f, fieldType, tgType.Fields, !sentByAPI, sentByAPI, map[string]bool{}),
+		})
+	}
+
+	checks, err := typedChecks(g.d, fields)
+	if err != nil {
+		return "", err
+	}
+
+	if oneOf := getOneOfCheck(tgType); oneOf != "" {
+		var names, conditions []string
+		for _, f := range fields {
+			if f.Required {
+				continue
+			}
+
+			condition := isSetCondition(g.d, f.expr, f.underlyingType(f.goType))
+			if condition == "" {
+				return "", fmt.Errorf("unable to check whether field %s is set", f.Name)
+			}
+			names = append(names, fmt.Sprintf("%q", f.Name))
+			conditions = append(conditions, condition)
+		}
+
+		checks += fmt.Sprintf("\nif err := %s([]string{%s}, %s); err != nil {\nreturn err\n}", oneOf, strings.Join(names, ", "), strings.Join(conditions, ", "))
+	}
+
+	return fmt.Sprintf(`
+// Validate checks the %[1]s fields against the constraints described in the Bot API documentation.
+func (v %[1]s) Validate() error {%[2]s
+	return nil
+}
+`, tgType.Name, checks), nil
+}
+
+func (g validationGenerator) generateOptsValidate(tgMethod MethodDescription) (string, error) {
+	var optionals []Field
+	for _, f := range tgMethod.Fields {
+		if !f.Required {
+			optionals = append(optionals, f)
+		}
+	}
+
+	var fields []validatedField
+	for _, f := range optionals {
+		fieldType, err := f.getPreferredType(g.d)
+		if err != nil {
+			return "", fmt.Errorf("failed to get field type of %s: %w", f.Name, err)
+		}
+
+		fields = append(fields, validatedField{
+			Field:  f,
+			goType: fieldType,
+			expr:   "opts." + snakeToTitle(f.Name),
+			c:      g.getFieldConstraints(f, fieldType, optionals, false, false, map[string]bool{}),
+		})
+	}
+
+	checks, err := typedChecks(g.d, fields)
+	if err != nil {
+		return "", err
+	}
+	if checks == "" {
+		return "", nil
+	}
+
+	return fmt.Sprintf(`
+// Validate checks the %[1]s fields against the constraints described in the Bot API documentation.
+func (opts *%[1]s) Validate() error {
+	if opts == nil {
+		return nil
+	}
+%[2]s
+	return nil
+}
+`, tgMethod.optsName(), checks), nil
+}
+
+// typedChecks generates the checks for a list of struct fields.
+func typedChecks(d APIDescription, fields []validatedField) (string, error) {
+	exprs := map[string]validatedField{}
+	for _, f := range fields {
+		exprs[f.Name] = f
+	}
+
+	checks := strings.Builder{}
+	for _, f := range fields {
+		isSet := isSetCondition(d, f.expr, f.underlyingType(f.goType))
+		if f.c.required {
+			checks.WriteString(checkStatement(fmt.Sprintf("checkRequired(%q, %s)", f.Name, isSet)))
+		}
+
+		if f.c.requiredUnless != nil {
+			var othersSet []string
+			for _, name := range f.c.requiredUnless {
+				other, ok := exprs[name]
+				if !ok {
+					return "", fmt.Errorf("unknown field %s in the requirements of %s", name, f.Name)
+				}
+				othersSet = append(othersSet, isSetCondition(d, other.expr, other.underlyingType(other.goType)))
+			}
+			checks.WriteString(checkStatement(fmt.Sprintf("checkRequiredUnless(%q, %s, %s, %s)", f.Name, isSet, strings.Join(othersSet, " || "), quoteAll(f.c.requiredUnless))))
+		}
+
+		if !f.c.hasValueChecks() {
+			continue
+		}
+
+		value := f.expr
+		if isPointer(f.goType) && !isTgType(d, strings.TrimPrefix(f.goType, "*")) {
+			value = "*" + f.expr
+		}
+
+		valueChecks := strings.Builder{}
+		if f.c.length != nil {
+			if f.c.parseModeField != "" {
+				parseMode, ok := exprs[f.c.parseModeField]
+				if !ok {
+					return "", fmt.Errorf("unknown parse mode field %s for %s", f.c.parseModeField, f.Name)
+				}
+				valueChecks.WriteString(checkStatement(fmt.Sprintf("checkParsedLength(%q, %s, %s, %s, %s)", f.Name, value, parseMode.expr, f.c.length.min, f.c.length.max)))
+			} else {
+				valueChecks.WriteString(checkStatement(fmt.Sprintf("checkLength(%q, %s, %s, %s, %t)", f.Name, value, f.c.length.min, f.c.length.max, f.c.lengthInBytes)))
+			}
+		}
+		if f.c.enum != nil {
+			valueChecks.WriteString(checkStatement(fmt.Sprintf("checkEnum(%q, %s, %s)", f.Name, value, strings.Join(f.c.enum, ", "))))
+		}
+		if f.c.number != nil {
+			valueChecks.WriteString(checkStatement(fmt.Sprintf("checkRange(%q, %s, %s, %s)", f.Name, value, f.c.number.min, f.c.number.max)))
+		}
+		if f.c.count != nil {
+			valueChecks.WriteString(checkStatement(fmt.Sprintf("checkCount(%q, len(%s), %s, %s)", f.Name, value, f.c.count.min, f.c.count.max)))
+		}
+		if f.c.nested {
+			switch {
+			case strings.HasPrefix(f.goType, "[][]"):
+				valueChecks.WriteString(checkStatement(fmt.Sprintf("validateRows(%q, %s)", f.Name, f.expr)))
+			case isArray(f.goType):
+				valueChecks.WriteString(checkStatement(fmt.Sprintf("validateEach(%q, %s)", f.Name, f.expr)))
+			default:
+				valueChecks.WriteString(checkStatement(fmt.Sprintf("validateField(%q, %s)", f.Name, f.expr)))
+			}
+		}
+
+		if f.Required || isSet == "" {
+			checks.WriteString(valueChecks.String())
+		} else {
+			checks.WriteString("\nif " + isSet + " {" + valueChecks.String() + "\n}")
+		}
+	}
+
+	return checks.String(), nil
+}
+
+func paramsValidatorName(tgMethodName string) string {
+	return "validate" + strings.Title(tgMethodName) + "Params"
+}
+
+func (g validationGenerator) generateParamsValidate(tgMethod MethodDescription) (string, error) {
+	// Required fields are set first, as in the generated methods.
+	var fields []Field
+	for _, f := range tgMethod.Fields {
+		if f.Required {
+			fields = append(fields, f)
+		}
+	}
+	for _, f := range tgMethod.Fields {
+		if !f.Required {
+			fields = append(fields, f)
+		}
+	}
+
+	checks := strings.Builder{}
+	for _, f := range fields {
+		goType, err := f.getPreferredType(g.d)
+		if err != nil {
+			return "", fmt.Errorf("failed to get field type of %s: %w", f.Name, err)
+		}
+
+		param := fmt.Sprintf("params[%q]", f.Name)
+		isSet := param + ` != ""`
+		c := g.getFieldConstraints(f, goType, tgMethod.Fields, strings.TrimPrefix(f.underlyingType(goType), "*") == "string", false, map[string]bool{})
+
+		if c.required {
+			checks.WriteString(checkStatement(fmt.Sprintf("checkRequired(%q, %s)", f.Name, isSet)))
+		}
+
+		if c.requiredUnless != nil {
+			var othersSet []string
+			for _, name := range c.requiredUnless {
+				othersSet = append(othersSet, fmt.Sprintf("params[%q] != \"\"", name))
+			}
+			checks.WriteString(checkStatement(fmt.Sprintf("checkRequiredUnless(%q, %s, %s, %s)", f.Name, isSet, strings.Join(othersSet, " || "), quoteAll(c.requiredUnless))))
+		}
+
+		valueChecks := strings.Builder{}
+		if c.length != nil {
+			if c.parseModeField != "" {
+				valueChecks.WriteString(checkStatement(fmt.Sprintf("checkParsedLength(%q, %s, params[%q], %s, %s)", f.Name, param, c.parseModeField, c.length.min, c.length.max)))
+			} else {
+				valueChecks.WriteString(checkStatement(fmt.Sprintf("checkLength(%q, %s, %s, %s, %t)", f.Name, param, c.length.min, c.length.max, c.lengthInBytes)))
+			}
+		}
+		if c.enum != nil {
+			valueChecks.WriteString(checkStatement(fmt.Sprintf("checkEnum(%q, %s, %s)", f.Name, param, strings.Join(c.enum, ", "))))
+		}
+		if c.number != nil {
+			valueChecks.WriteString(checkStatement(fmt.Sprintf("checkRangeParam(%q, %s, %s, %s)", f.Name, param, c.number.min, c.number.max)))
+		}
+		if c.count != nil {
+			valueChecks.WriteString(checkStatement(fmt.Sprintf("checkCountParam(%q, %s, %s, %s)", f.Name, param, c.count.min, c.count.max)))
+		}
+		if c.nested {
+			// Only concrete types can be decoded; interfaces (other than the reply markup) are left to telegram.
+			elemType := stripPointersAndArrays(goType)
+			switch {
+			case goType == typeReplyMarkup:
+				valueChecks.WriteString(checkStatement(fmt.Sprintf("validateReplyMarkupParam(%q, %s)", f.Name, param)))
+			case isInterfaceType(g.d, elemType):
+			case strings.HasPrefix(goType, "[][]"):
+				valueChecks.WriteString(checkStatement(fmt.Sprintf("validateRowsParam[%s](%q, %s)", elemType, f.Name, param)))
+			case isArray(goType):
+				valueChecks.WriteString(checkStatement(fmt.Sprintf("validateEachParam[%s](%q, %s)", elemType, f.Name, param)))
+			default:
+				valueChecks.WriteString(checkStatement(fmt.Sprintf("validateParam[%s](%q, %s)", elemType, f.Name, param)))
+			}
+		}
+
+		if valueChecks.Len() == 0 {
+			continue
+		}
+		if f.Required {
+			checks.WriteString(valueChecks.String())
+		} else {
+			checks.WriteString("\nif " + isSet + " {" + valueChecks.String() + "\n}")
+		}
+	}
+
+	if checks.Len() == 0 {
+		return "", nil
+	}
+
+	return fmt.Sprintf(`
+// %s checks the parameters of a %s request.
+func %s(params map[string]string) error {%s
+	return nil
+}
+`, paramsValidatorName(tgMethod.Name), tgMethod.Name, paramsValidatorName(tgMethod.Name), checks.String()), nil
+}
+
+func checkStatement(check string) string {
+	return "\nif err := " + check + "; err != nil {\nreturn err\n}"
+}
+
+func quoteAll(ss []string) string {
+	var quoted []string
+	for _, s := range ss {
+		quoted = append(quoted, fmt.Sprintf("%q", s))
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -129,18 +129,18 @@ func (s Sender) IsBot() bool {
 // IsAnonymousAdmin returns true if the Sender is an anonymous admin sending to a group.
 // For channel posts in a channel, see IsChannelPost.
 func (s Sender) IsAnonymousAdmin() bool {
-	return s.Chat != nil && s.Chat.Id == s.ChatId && s.Chat.Type != "channel"
+	return s.Chat != nil && s.Chat.Id == s.ChatId && s.Chat.Type != ChatTypeChannel
 }
 
 // IsChannelPost returns true if the Sender is a channel admin posting to that same channel.
 func (s Sender) IsChannelPost() bool {
-	return s.Chat != nil && s.Chat.Id == s.ChatId && s.Chat.Type == "channel"
+	return s.Chat != nil && s.Chat.Id == s.ChatId && s.Chat.Type == ChatTypeChannel
 }
 
 // IsAnonymousChannel returns true if the Sender is an anonymous channel sending to a group.
 // For channel admins posting in their own channel, see IsChannelPost.
 func (s Sender) IsAnonymousChannel() bool {
-	return s.Chat != nil && s.Chat.Id != s.ChatId && !s.IsAutomaticForward && s.Chat.Type == "channel"
+	return s.Chat != nil && s.Chat.Id != s.ChatId && !s.IsAutomaticForward && s.Chat.Type == ChatTypeChannel
 }
 
 // IsLinkedChannel returns true if the Sender is a linked channel sending to the group it is linked to.
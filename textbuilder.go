@@ -0,0 +1,181 @@
+package gotgbot
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+// TextBuilder builds formatted text, without having to escape user input by hand. The result can be sent either as
+// plain text with a list of entities (which avoids any parsing issues), or as escaped HTML or MarkdownV2.
+//
+// Entities can be nested by starting an entity, adding its contents, and ending it:
+//
+//	t := gotgbot.NewTextBuilder().
+//		Text("Hello, ").
+//		Start(gotgbot.MessageEntity{Type: gotgbot.MessageEntityTypeBold}).Text("dear ").Mention(user.FirstName, user.Id).End().
+//		Text("! Your code is ").Code(code)
+//
+//	b.SendMessage(chatId, t.String(), &gotgbot.SendMessageOpts{Entities: t.Entities()})
+type TextBuilder struct {
+	// text contains the plain text built so far.
+	text strings.Builder
+	// length is the length of the text, in UTF-16 code units.
+	length int64
+	// entities contains all the entities added so far, in the order they were started.
+	entities []MessageEntity
+	// open contains the indexes of the entities which have been started, but not ended.
+	open []int
+}
+
+// NewTextBuilder creates a new, empty, TextBuilder.
+func NewTextBuilder() *TextBuilder {
+	return &TextBuilder{}
+}
+
+// Text adds plain text.
+func (t *TextBuilder) Text(text string) *TextBuilder {
+	t.text.WriteString(text)
+	t.length += utf16Length(text)
+	return t
+}
+
+// Start starts a new entity, which contains all the text added until the matching call to End. The offset and length
+// of the entity are computed automatically.
+func (t *TextBuilder) Start(entity MessageEntity) *TextBuilder {
+	entity.Offset = t.length
+	entity.Length = 0
+	t.open = append(t.open, len(t.entities))
+	t.entities = append(t.entities, entity)
+	return t
+}
+
+// End ends the last started entity. Empty entities are discarded, since telegram does not allow them.
+func (t *TextBuilder) End() *TextBuilder {
+	if len(t.open) == 0 {
+		return t
+	}
+
+	idx := t.open[len(t.open)-1]
+	t.open = t.open[:len(t.open)-1]
+	t.entities[idx].Length = t.length - t.entities[idx].Offset
+	return t
+}
+
+// Entity adds text contained in a single entity.
+func (t *TextBuilder) Entity(text string, entity MessageEntity) *TextBuilder {
+	return t.Start(entity).Text(text).End()
+}
+
+// Bold adds bold text.
+func (t *TextBuilder) Bold(text string) *TextBuilder {
+	return t.Entity(text, MessageEntity{Type: MessageEntityTypeBold})
+}
+
+// Italic adds italic text.
+func (t *TextBuilder) Italic(text string) *TextBuilder {
+	return t.Entity(text, MessageEntity{Type: MessageEntityTypeItalic})
+}
+
+// Underline adds underlined text.
+func (t *TextBuilder) Underline(text string) *TextBuilder {
+	return t.Entity(text, MessageEntity{Type: MessageEntityTypeUnderline})
+}
+
+// Strikethrough adds strikethrough text.
+func (t *TextBuilder) Strikethrough(text string) *TextBuilder {
+	return t.Entity(text, MessageEntity{Type: MessageEntityTypeStrikethrough})
+}
+
+// Spoiler adds text hidden behind a spoiler.
+func (t *TextBuilder) Spoiler(text string) *TextBuilder {
+	return t.Entity(text, MessageEntity{Type: MessageEntityTypeSpoiler})
+}
+
+// Code adds inline monowidth text.
+func (t *TextBuilder) Code(text string) *TextBuilder {
+	return t.Entity(text, MessageEntity{Type: MessageEntityTypeCode})
+}
+
+// Pre adds a monowidth block, with an optional programming language.
+func (t *TextBuilder) Pre(text string, language string) *TextBuilder {
+	return t.Entity(text, MessageEntity{Type: MessageEntityTypePre, Language: language})
+}
+
+// Link adds text which opens the URL when clicked.
+func (t *TextBuilder) Link(text string, url string) *TextBuilder {
+	return t.Entity(text, MessageEntity{Type: MessageEntityTypeTextLink, Url: url})
+}
+
+// Mention adds text which mentions a user by ID; this works for users without usernames.
+func (t *TextBuilder) Mention(text string, userId int64) *TextBuilder {
+	return t.Entity(text, MessageEntity{Type: MessageEntityTypeTextMention, User: &User{Id: userId}})
+}
+
+// CustomEmoji adds a custom emoji; the emoji text is displayed by clients which can't show the custom emoji.
+func (t *TextBuilder) CustomEmoji(emoji string, customEmojiId string) *TextBuilder {
+	return t.Entity(emoji, MessageEntity{Type: MessageEntityTypeCustomEmoji, CustomEmojiId: customEmojiId})
+}
+
+// Blockquote adds a block quotation.
+func (t *TextBuilder) Blockquote(text string) *TextBuilder {
+	return t.Entity(text, MessageEntity{Type: MessageEntityTypeBlockquote})
+}
+
+// ExpandableBlockquote adds a block quotation which is collapsed by default.
+func (t *TextBuilder) ExpandableBlockquote(text string) *TextBuilder {
+	return t.Entity(text, MessageEntity{Type: MessageEntityTypeExpandableBlockquote})
+}
+
+// String returns the plain text, without any formatting.
+func (t *TextBuilder) String() string {
+	return t.text.String()
+}
+
+// Entities returns the entities of the text, sorted as telegram expects them. Entities which haven't been ended yet
+// are ended at the end of the text.
+func (t *TextBuilder) Entities() []MessageEntity {
+	entities := make([]MessageEntity, 0, len(t.entities))
+	for idx, e := range t.entities {
+		if t.isOpen(idx) {
+			e.Length = t.length - e.Offset
+		}
+		if e.Length == 0 {
+			continue
+		}
+		entities = append(entities, e)
+	}
+	return entities
+}
+
+// isOpen checks whether the entity at the given index has not been ended yet.
+func (t *TextBuilder) isOpen(idx int) bool {
+	for _, o := range t.open {
+		if o == idx {
+			return true
+		}
+	}
+	return false
+}
+
+// HTML returns the text formatted as HTML, with all user input escaped.
+func (t *TextBuilder) HTML() string {
+	return getOrigMsgHTML(utf16.Encode([]rune(t.String())), t.Entities())
+}
+
+// MarkdownV2 returns the text formatted as MarkdownV2, with all user input escaped.
+func (t *TextBuilder) MarkdownV2() string {
+	return getOrigMsgMDV2(utf16.Encode([]rune(t.String())), t.Entities())
+}
+
+// utf16Length returns the length of a string in UTF-16 code units, as used by telegram for entity offsets.
+func utf16Length(s string) int64 {
+	var n int64
+	for _, r := range s {
+		if r >= 0x10000 {
+			n += 2
+		} else {
+			n++
+		}
+	}
+	return n
+}
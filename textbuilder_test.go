@@ -0,0 +1,110 @@
+package gotgbot_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+)
+
+func TestTextBuilder(t *testing.T) {
+	for name, tc := range map[string]struct {
+		build    func(t *gotgbot.TextBuilder) *gotgbot.TextBuilder
+		text     string
+		entities []gotgbot.MessageEntity
+		html     string
+		mdv2     string
+	}{
+		"plain": {
+			build: func(t *gotgbot.TextBuilder) *gotgbot.TextBuilder {
+				return t.Text("1 < 2 & [a](b) *c*.")
+			},
+			text:     "1 < 2 & [a](b) *c*.",
+			entities: []gotgbot.MessageEntity{},
+			html:     "1 &lt; 2 &amp; [a](b) *c*.",
+			mdv2:     `1 < 2 & \[a\]\(b\) \*c\*\.`,
+		},
+		"simple entities": {
+			build: func(t *gotgbot.TextBuilder) *gotgbot.TextBuilder {
+				return t.Text("Hi ").Bold("bold").Text(" ").Italic("it_alic").Text(" ").Code("a`b").Text(" ").
+					Link("link", "https://example.com/?a=1&b=(2)")
+			},
+			text: "Hi bold it_alic a`b link",
+			entities: []gotgbot.MessageEntity{
+				{Type: "bold", Offset: 3, Length: 4},
+				{Type: "italic", Offset: 8, Length: 7},
+				{Type: "code", Offset: 16, Length: 3},
+				{Type: "text_link", Offset: 20, Length: 4, Url: "https://example.com/?a=1&b=(2)"},
+			},
+			html: `Hi <b>bold</b> <i>it_alic</i> <code>a` + "`" + `b</code> <a href="https://example.com/?a=1&amp;b=(2)">link</a>`,
+			mdv2: `Hi *bold* _it\_alic_ ` + "`a\\`b`" + ` [link](https://example.com/?a=1&b=(2\))`,
+		},
+		"nested": {
+			build: func(t *gotgbot.TextBuilder) *gotgbot.TextBuilder {
+				return t.Start(gotgbot.MessageEntity{Type: "bold"}).
+					Text("a ").Italic("b").Text(" ").Mention("c", 123).
+					End().
+					Spoiler("d")
+			},
+			text: "a b cd",
+			entities: []gotgbot.MessageEntity{
+				{Type: "bold", Offset: 0, Length: 5},
+				{Type: "italic", Offset: 2, Length: 1},
+				{Type: "text_mention", Offset: 4, Length: 1, User: &gotgbot.User{Id: 123}},
+				{Type: "spoiler", Offset: 5, Length: 1},
+			},
+			html: `<b>a <i>b</i> <a href="tg://user?id=123">c</a></b><span class="tg-spoiler">d</span>`,
+			mdv2: `*a _b_ [c](tg://user?id=123)*||d||`,
+		},
+		"utf16 offsets": {
+			build: func(t *gotgbot.TextBuilder) *gotgbot.TextBuilder {
+				return t.Text("😀 ").CustomEmoji("👍", "5368324170671202286").Bold("é")
+			},
+			text: "😀 👍é",
+			entities: []gotgbot.MessageEntity{
+				{Type: "custom_emoji", Offset: 3, Length: 2, CustomEmojiId: "5368324170671202286"},
+				{Type: "bold", Offset: 5, Length: 1},
+			},
+			html: `😀 <tg-emoji emoji-id="5368324170671202286">👍</tg-emoji><b>é</b>`,
+			mdv2: `😀 ![👍](tg://emoji?id=5368324170671202286)*é*`,
+		},
+		"blockquote": {
+			build: func(t *gotgbot.TextBuilder) *gotgbot.TextBuilder {
+				return t.Blockquote("line 1\nline 2").Text("\n").Pre("x := 1", "go")
+			},
+			text: "line 1\nline 2\nx := 1",
+			entities: []gotgbot.MessageEntity{
+				{Type: "blockquote", Offset: 0, Length: 13},
+				{Type: "pre", Offset: 14, Length: 6, Language: "go"},
+			},
+			html: "<blockquote>line 1\nline 2</blockquote>\n<pre><code class=\"language-go\">x := 1</code></pre>",
+			mdv2: ">line 1\n>line 2\n```go\nx := 1```",
+		},
+		"unbalanced and empty": {
+			build: func(t *gotgbot.TextBuilder) *gotgbot.TextBuilder {
+				return t.Bold("").End().Start(gotgbot.MessageEntity{Type: "underline"}).Text("open")
+			},
+			text:     "open",
+			entities: []gotgbot.MessageEntity{{Type: "underline", Offset: 0, Length: 4}},
+			html:     "<u>open</u>",
+			mdv2:     "__open__",
+		},
+	} {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			b := tc.build(gotgbot.NewTextBuilder())
+			if got := b.String(); got != tc.text {
+				t.Errorf("expected text %q, got %q", tc.text, got)
+			}
+			if got := b.Entities(); !reflect.DeepEqual(got, tc.entities) {
+				t.Errorf("expected entities %+v, got %+v", tc.entities, got)
+			}
+			if got := b.HTML(); got != tc.html {
+				t.Errorf("expected HTML %q, got %q", tc.html, got)
+			}
+			if got := b.MarkdownV2(); got != tc.mdv2 {
+				t.Errorf("expected MarkdownV2 %q, got %q", tc.mdv2, got)
+			}
+		})
+	}
+}
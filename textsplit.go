@@ -0,0 +1,195 @@
+package gotgbot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unicode/utf16"
+)
+
+var ErrCannotSplitParseMode = errors.New("cannot split text formatted with the legacy Markdown parse mode; use entities instead")
+
+// Maximum lengths allowed by telegram, in UTF-16 code units.
+const (
+	// MaxMessageTextLength is the maximum length of a message text.
+	MaxMessageTextLength = 4096
+	// MaxCaptionLength is the maximum length of a media caption.
+	MaxCaptionLength = 1024
+)
+
+// TextChunk is a part of a longer text, along with the entities it contains.
+type TextChunk struct {
+	// Text is the text of the chunk.
+	Text string
+	// Entities contains the entities of the chunk, with offsets relative to the start of the chunk.
+	Entities []MessageEntity
+}
+
+// SplitText splits a text and its entities into chunks of at most limit UTF-16 code units, such that each chunk can
+// be sent as a separate message.
+//
+// Texts are split at the last paragraph break which fits in the chunk; falling back to line breaks, spaces, and
+// finally any character. Splitting inside an entity is avoided where possible; entities which do get split are
+// re-opened at the start of the next chunk. Surrogate pairs are never split.
+func SplitText(text string, entities []MessageEntity, limit int) []TextChunk {
+	data := utf16.Encode([]rune(text))
+	if len(data) <= limit || limit <= 0 {
+		return []TextChunk{{Text: text, Entities: entities}}
+	}
+
+	var chunks []TextChunk
+	start := 0
+	for start < len(data) {
+		end, next := len(data), len(data)
+		if len(data)-start > limit {
+			end, next = splitPoint(data, entities, start, start+limit)
+		}
+
+		if chunk := newTextChunk(data, entities, start, end); chunk.Text != "" {
+			chunks = append(chunks, chunk)
+		}
+		start = next
+	}
+	return chunks
+}
+
+// SplitCaption splits a caption and its entities into the part which fits in a media caption, and the rest of the text
+// split into chunks which fit into regular messages.
+func SplitCaption(caption string, entities []MessageEntity) (TextChunk, []TextChunk) {
+	data := utf16.Encode([]rune(caption))
+	if len(data) <= MaxCaptionLength {
+		return TextChunk{Text: caption, Entities: entities}, nil
+	}
+
+	end, next := splitPoint(data, entities, 0, MaxCaptionLength)
+	rest := newTextChunk(data, entities, next, len(data))
+	return newTextChunk(data, entities, 0, end), SplitText(rest.Text, rest.Entities, MaxMessageTextLength)
+}
+
+// splitPoint finds where to split the text, such that the chunk ends before limit. It returns the end of the current
+// chunk, and the start of the next one; any separating whitespace between them is dropped.
+func splitPoint(data []uint16, entities []MessageEntity, start int, limit int) (int, int) {
+	for _, allowInEntity := range []bool{false, true} {
+		for _, sep := range [][]uint16{{'\n', '\n'}, {'\n'}, {' '}} {
+			for i := limit; i > start; i-- {
+				if !hasSeparator(data, i, sep) {
+					continue
+				}
+				if !allowInEntity && insideEntity(entities, i) {
+					continue
+				}
+				return i, i + len(sep)
+			}
+		}
+	}
+
+	// No good boundary; cut at the limit, avoiding splitting surrogate pairs.
+	end := limit
+	if utf16.IsSurrogate(rune(data[end-1])) && data[end-1] < 0xdc00 && end-1 > start {
+		end--
+	}
+	return end, end
+}
+
+// hasSeparator checks whether the separator is at the given position.
+func hasSeparator(data []uint16, pos int, sep []uint16) bool {
+	if pos+len(sep) > len(data) {
+		return false
+	}
+	for i, c := range sep {
+		if data[pos+i] != c {
+			return false
+		}
+	}
+	return true
+}
+
+// insideEntity checks whether splitting at the given position would cut an entity in two.
+func insideEntity(entities []MessageEntity, pos int) bool {
+	for _, e := range entities {
+		if e.Offset < int64(pos) && int64(pos) < e.Offset+e.Length {
+			return true
+		}
+	}
+	return false
+}
+
+// newTextChunk extracts the text between start and end, and clips the entities to fit in it.
+func newTextChunk(data []uint16, entities []MessageEntity, start int, end int) TextChunk {
+	var chunkEntities []MessageEntity
+	for _, e := range entities {
+		entStart := e.Offset
+		entEnd := e.Offset + e.Length
+		if entStart < int64(start) {
+			entStart = int64(start)
+		}
+		if entEnd > int64(end) {
+			entEnd = int64(end)
+		}
+		if entStart >= entEnd {
+			continue
+		}
+
+		e.Offset = entStart - int64(start)
+		e.Length = entEnd - entStart
+		chunkEntities = append(chunkEntities, e)
+	}
+
+	return TextChunk{
+		Text:     string(utf16.Decode(data[start:end])),
+		Entities: chunkEntities,
+	}
+}
+
+// SendLongMessage sends a text message of any length, by splitting it into chunks of at most MaxMessageTextLength;
+// see SplitText. Each chunk is sent as a reply to the previous one. The reply markup is only attached to the last
+// chunk, and the message effect to the first.
+//
+// Since the text is split using its entities, texts formatted with a ParseMode are parsed locally and sent with
+// entities instead; see ParseText. The legacy Markdown parse mode cannot be used for texts which need to be split.
+// All the messages which were sent are returned, even when an error occurs partway.
+func (bot *Bot) SendLongMessage(chatId int64, text string, opts *SendMessageOpts) ([]*Message, error) {
+	return bot.SendLongMessageWithContext(context.Background(), chatId, text, opts)
+}
+
+// SendLongMessageWithContext is the same as Bot.SendLongMessage, but with a context.Context parameter.
+func (bot *Bot) SendLongMessageWithContext(ctx context.Context, chatId int64, text string, opts *SendMessageOpts) ([]*Message, error) {
+	var chunkOpts SendMessageOpts
+	if opts != nil {
+		chunkOpts = *opts
+	}
+
+	chunks := SplitText(text, chunkOpts.Entities, MaxMessageTextLength)
+	if len(chunks) > 1 && chunkOpts.ParseMode != "" {
+		plain, entities, err := ParseText(text, chunkOpts.ParseMode)
+		if err != nil {
+			if errors.Is(err, ErrUnsupportedParseMode) {
+				return nil, ErrCannotSplitParseMode
+			}
+			return nil, fmt.Errorf("failed to parse message text: %w", err)
+		}
+		chunkOpts.ParseMode = ParseModeNone
+		chunks = SplitText(plain, entities, MaxMessageTextLength)
+	}
+
+	replyMarkup := chunkOpts.ReplyMarkup
+	msgs := make([]*Message, 0, len(chunks))
+	for idx, chunk := range chunks {
+		chunkOpts.Entities = chunk.Entities
+		chunkOpts.ReplyMarkup = nil
+		if idx == len(chunks)-1 {
+			chunkOpts.ReplyMarkup = replyMarkup
+		}
+		if idx > 0 {
+			chunkOpts.MessageEffectId = ""
+			chunkOpts.ReplyParameters = &ReplyParameters{MessageId: msgs[idx-1].MessageId}
+		}
+
+		msg, err := bot.SendMessageWithContext(ctx, chatId, chunk.Text, &chunkOpts)
+		if err != nil {
+			return msgs, fmt.Errorf("failed to send message chunk %d of %d: %w", idx+1, len(chunks), err)
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
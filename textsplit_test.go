@@ -0,0 +1,194 @@
+package gotgbot_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/PaulSonOfLars/gotgbot/v2/gotgbottest"
+)
+
+func TestSplitText(t *testing.T) {
+	for name, tc := range map[string]struct {
+		text     string
+		entities []gotgbot.MessageEntity
+		limit    int
+		expected []gotgbot.TextChunk
+	}{
+		"short": {
+			text:     "hello",
+			limit:    10,
+			expected: []gotgbot.TextChunk{{Text: "hello"}},
+		},
+		"paragraphs before lines": {
+			text:     "aaa\n\nbbb\nccc dd",
+			limit:    12,
+			expected: []gotgbot.TextChunk{{Text: "aaa"}, {Text: "bbb\nccc dd"}},
+		},
+		"lines before words": {
+			text:     "aaa bbb\nccc ddd",
+			limit:    10,
+			expected: []gotgbot.TextChunk{{Text: "aaa bbb"}, {Text: "ccc ddd"}},
+		},
+		"words": {
+			text:     "aaa bbb ccc ddd",
+			limit:    8,
+			expected: []gotgbot.TextChunk{{Text: "aaa bbb"}, {Text: "ccc ddd"}},
+		},
+		"hard cut": {
+			text:     "aaaaabbbbbcc",
+			limit:    5,
+			expected: []gotgbot.TextChunk{{Text: "aaaaa"}, {Text: "bbbbb"}, {Text: "cc"}},
+		},
+		"surrogate pairs": {
+			text:     "aa😀😀",
+			limit:    3,
+			expected: []gotgbot.TextChunk{{Text: "aa"}, {Text: "😀"}, {Text: "😀"}},
+		},
+		"avoid cutting entities": {
+			text:     "aaa bbb ccc",
+			entities: []gotgbot.MessageEntity{{Type: "bold", Offset: 0, Length: 7}},
+			limit:    10,
+			expected: []gotgbot.TextChunk{
+				{Text: "aaa bbb", Entities: []gotgbot.MessageEntity{{Type: "bold", Offset: 0, Length: 7}}},
+				{Text: "ccc"},
+			},
+		},
+		"reopen cut entities": {
+			text: "aaa bbb ccc",
+			entities: []gotgbot.MessageEntity{
+				{Type: "bold", Offset: 0, Length: 11},
+				{Type: "text_link", Offset: 4, Length: 7, Url: "https://example.com"},
+			},
+			limit: 8,
+			expected: []gotgbot.TextChunk{
+				{Text: "aaa bbb", Entities: []gotgbot.MessageEntity{
+					{Type: "bold", Offset: 0, Length: 7},
+					{Type: "text_link", Offset: 4, Length: 3, Url: "https://example.com"},
+				}},
+				{Text: "ccc", Entities: []gotgbot.MessageEntity{
+					{Type: "bold", Offset: 0, Length: 3},
+					{Type: "text_link", Offset: 0, Length: 3, Url: "https://example.com"},
+				}},
+			},
+		},
+		"entity offsets after surrogates": {
+			text:     "😀 aa\n😀 bb",
+			entities: []gotgbot.MessageEntity{{Type: "italic", Offset: 9, Length: 2}},
+			limit:    6,
+			expected: []gotgbot.TextChunk{
+				{Text: "😀 aa"},
+				{Text: "😀 bb", Entities: []gotgbot.MessageEntity{{Type: "italic", Offset: 3, Length: 2}}},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got := gotgbot.SplitText(tc.text, tc.entities, tc.limit)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestSplitCaption(t *testing.T) {
+	caption := strings.Repeat("a", 1000) + "\n" + strings.Repeat("b", 5000)
+	first, rest := gotgbot.SplitCaption(caption, []gotgbot.MessageEntity{{Type: "bold", Offset: 990, Length: 20}})
+
+	if first.Text != strings.Repeat("a", 1000) {
+		t.Fatalf("expected the caption to be split at the line break, got %d characters", len(first.Text))
+	}
+	if !reflect.DeepEqual(first.Entities, []gotgbot.MessageEntity{{Type: "bold", Offset: 990, Length: 10}}) {
+		t.Errorf("unexpected caption entities: %+v", first.Entities)
+	}
+
+	if len(rest) != 2 || len(rest[0].Text) != gotgbot.MaxMessageTextLength || len(rest[1].Text) != 5000-gotgbot.MaxMessageTextLength {
+		t.Fatalf("expected the rest to be split into 2 messages, got %d", len(rest))
+	}
+	if !reflect.DeepEqual(rest[0].Entities, []gotgbot.MessageEntity{{Type: "bold", Offset: 0, Length: 9}}) {
+		t.Errorf("unexpected entities in the rest of the text: %+v", rest[0].Entities)
+	}
+}
+
+func TestSendLongMessage(t *testing.T) {
+	s := gotgbottest.NewServer()
+	defer s.Close()
+
+	b, err := s.NewBot()
+	if err != nil {
+		t.Fatalf("failed to create bot: %v", err)
+	}
+
+	text := strings.Repeat("a", 4000) + "\n\n" + strings.Repeat("b", 4090) + "\n\n" + strings.Repeat("c", 10)
+	markup := gotgbot.InlineKeyboardMarkup{InlineKeyboard: [][]gotgbot.InlineKeyboardButton{{{Text: "ok", CallbackData: "ok"}}}}
+	msgs, err := b.SendLongMessage(1, text, &gotgbot.SendMessageOpts{
+		Entities:    []gotgbot.MessageEntity{{Type: "bold", Offset: 3990, Length: 20}},
+		ReplyMarkup: markup,
+	})
+	if err != nil {
+		t.Fatalf("failed to send long message: %v", err)
+	}
+	if len(msgs) != 3 {
+		t.Fatalf("expected 3 messages, got %d", len(msgs))
+	}
+
+	calls := s.CallsTo("sendMessage")
+	for idx, c := range calls {
+		if idx > 0 {
+			var reply gotgbot.ReplyParameters
+			if err := c.DecodeParam("reply_parameters", &reply); err != nil || reply.MessageId != msgs[idx-1].MessageId {
+				t.Errorf("expected chunk %d to reply to the previous chunk, got %+v (%v)", idx+1, reply, err)
+			}
+		}
+		if hasMarkup := c.Param("reply_markup") != ""; hasMarkup != (idx == len(calls)-1) {
+			t.Errorf("expected only the last chunk to have a reply markup; chunk %d: %v", idx+1, hasMarkup)
+		}
+	}
+
+	if _, err := b.SendLongMessage(1, text, &gotgbot.SendMessageOpts{ParseMode: gotgbot.ParseModeMarkdown}); !errors.Is(err, gotgbot.ErrCannotSplitParseMode) {
+		t.Errorf("expected ErrCannotSplitParseMode, got %v", err)
+	}
+}
+
+func TestSendLongMessageParseMode(t *testing.T) {
+	s := gotgbottest.NewServer()
+	defer s.Close()
+
+	b, err := s.NewBot()
+	if err != nil {
+		t.Fatalf("failed to create bot: %v", err)
+	}
+
+	text := strings.Repeat("a", 4000) + "\n\n<b>bold &amp; " + strings.Repeat("b", 100) + "</b>"
+	msgs, err := b.SendLongMessage(1, text, &gotgbot.SendMessageOpts{ParseMode: gotgbot.ParseModeHTML})
+	if err != nil {
+		t.Fatalf("failed to send long message: %v", err)
+	}
+	if len(msgs) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(msgs))
+	}
+
+	last := s.CallsTo("sendMessage")[1]
+	if last.Param("parse_mode") != "" {
+		t.Errorf("expected the text to be sent without a parse mode, got %q", last.Param("parse_mode"))
+	}
+	if last.Param("text") != "bold & "+strings.Repeat("b", 100) {
+		t.Errorf("unexpected text: %q", last.Param("text"))
+	}
+
+	var entities []gotgbot.MessageEntity
+	if err := last.DecodeParam("entities", &entities); err != nil {
+		t.Fatalf("failed to decode entities: %v", err)
+	}
+	if !reflect.DeepEqual(entities, []gotgbot.MessageEntity{{Type: "bold", Offset: 0, Length: 107}}) {
+		t.Errorf("unexpected entities: %+v", entities)
+	}
+
+	var parseErr *gotgbot.ParseEntitiesError
+	if _, err := b.SendLongMessage(1, strings.Repeat("a", 5000)+"<b>", &gotgbot.SendMessageOpts{ParseMode: gotgbot.ParseModeHTML}); !errors.As(err, &parseErr) {
+		t.Errorf("expected a ParseEntitiesError, got %v", err)
+	}
+}
@@ -0,0 +1,235 @@
+package gotgbot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ValidationError is returned when a value does not respect the constraints described in the Bot API documentation.
+type ValidationError struct {
+	// Method is the name of the API method being called, if any.
+	Method string
+	// Field is the path to the invalid field, using the JSON field names (eg, reply_markup.inline_keyboard[0][1]).
+	// Empty if the error applies to the whole object.
+	Field string
+	// Reason describes why the value is invalid.
+	Reason string
+}
+
+func (err *ValidationError) Error() string {
+	msg := err.Reason
+	if err.Field != "" {
+		msg = err.Field + " " + msg
+	}
+	if err.Method != "" {
+		return "invalid " + err.Method + " request: " + msg
+	}
+	return "invalid value: " + msg
+}
+
+// validator is implemented by all the generated types and opts which have constraints.
+type validator interface {
+	Validate() error
+}
+
+// prefixValidationError adds the path of the parent field to a ValidationError.
+func prefixValidationError(field string, err error) error {
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		return err
+	}
+
+	prefixed := *validationErr
+	if prefixed.Field == "" {
+		prefixed.Field = field
+	} else {
+		prefixed.Field = field + "." + prefixed.Field
+	}
+	return &prefixed
+}
+
+func checkRequired(field string, set bool) error {
+	if !set {
+		return &ValidationError{Field: field, Reason: "must be set"}
+	}
+	return nil
+}
+
+func checkRequiredUnless(field string, set bool, othersSet bool, others ...string) error {
+	if set || othersSet {
+		return nil
+	}
+
+	verb := "is"
+	if len(others) > 1 {
+		verb = "are"
+	}
+	return &ValidationError{Field: field, Reason: fmt.Sprintf("must be set when %s %s not specified", strings.Join(others, " and "), verb)}
+}
+
+func checkLength(field string, value string, min int, max int, inBytes bool) error {
+	unit := "characters"
+	length := int(utf16Length(value))
+	if inBytes {
+		unit = "bytes"
+		length = len(value)
+	}
+
+	if length < min || length > max {
+		return &ValidationError{Field: field, Reason: fmt.Sprintf("must be %d-%d %s long, got %d", min, max, unit, length)}
+	}
+	return nil
+}
+
+// checkParsedLength checks the length of a text once its entities have been parsed. Texts which can't be parsed
+// locally are left for telegram to check.
+func checkParsedLength(field string, value string, parseMode string, min int, max int) error {
+	text, _, err := ParseText(value, parseMode)
+	if err != nil {
+		return nil
+	}
+	return checkLength(field, text, min, max, false)
+}
+
+func checkEnum[T ~string](field string, value T, allowed ...T) error {
+	for _, v := range allowed {
+		if value == v {
+			return nil
+		}
+	}
+	return &ValidationError{Field: field, Reason: fmt.Sprintf("must be one of %q, got %q", allowed, value)}
+}
+
+func checkRange[T int64 | float64](field string, value T, min T, max T) error {
+	if value < min || value > max {
+		return &ValidationError{Field: field, Reason: fmt.Sprintf("must be between %v and %v, got %v", min, max, value)}
+	}
+	return nil
+}
+
+func checkCount(field string, count int, min int, max int) error {
+	if count < min || count > max {
+		return &ValidationError{Field: field, Reason: fmt.Sprintf("must contain %d-%d items, got %d", min, max, count)}
+	}
+	return nil
+}
+
+func checkExactlyOne(fields []string, set ...bool) error {
+	if countSet(set) != 1 {
+		return &ValidationError{Reason: "must have exactly one of " + strings.Join(fields, ", ") + " set"}
+	}
+	return nil
+}
+
+func checkAtMostOne(fields []string, set ...bool) error {
+	if countSet(set) > 1 {
+		return &ValidationError{Reason: "must have at most one of " + strings.Join(fields, ", ") + " set"}
+	}
+	return nil
+}
+
+func countSet(set []bool) int {
+	n := 0
+	for _, s := range set {
+		if s {
+			n++
+		}
+	}
+	return n
+}
+
+// validateField validates a nested value, if it has any constraints.
+func validateField(field string, v interface{}) error {
+	val, ok := v.(validator)
+	if !ok {
+		return nil
+	}
+
+	if err := val.Validate(); err != nil {
+		return prefixValidationError(field, err)
+	}
+	return nil
+}
+
+func validateEach[T any](field string, vs []T) error {
+	for idx, v := range vs {
+		if err := validateField(fmt.Sprintf("%s[%d]", field, idx), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateRows[T any](field string, rows [][]T) error {
+	for idx, row := range rows {
+		if err := validateEach(fmt.Sprintf("%s[%d]", field, idx), row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// The param checks below work on the encoded request parameters. Values which can't be decoded are left for telegram
+// to check.
+
+func checkRangeParam(field string, value string, min float64, max float64) error {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil
+	}
+	return checkRange(field, v, min, max)
+}
+
+func checkCountParam(field string, value string, min int, max int) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal([]byte(value), &items); err != nil {
+		return nil
+	}
+	return checkCount(field, len(items), min, max)
+}
+
+func validateParam[T any](field string, value string) error {
+	var v T
+	if err := json.Unmarshal([]byte(value), &v); err != nil {
+		return nil
+	}
+	return validateField(field, v)
+}
+
+func validateEachParam[T any](field string, value string) error {
+	var vs []T
+	if err := json.Unmarshal([]byte(value), &vs); err != nil {
+		return nil
+	}
+	return validateEach(field, vs)
+}
+
+func validateRowsParam[T any](field string, value string) error {
+	var rows [][]T
+	if err := json.Unmarshal([]byte(value), &rows); err != nil {
+		return nil
+	}
+	return validateRows(field, rows)
+}
+
+// validateReplyMarkupParam validates a reply markup, after finding out which kind of markup it is.
+func validateReplyMarkupParam(field string, value string) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(value), &fields); err != nil {
+		return nil
+	}
+
+	switch {
+	case fields["inline_keyboard"] != nil:
+		return validateParam[InlineKeyboardMarkup](field, value)
+	case fields["keyboard"] != nil:
+		return validateParam[ReplyKeyboardMarkup](field, value)
+	case fields["force_reply"] != nil:
+		return validateParam[ForceReply](field, value)
+	default:
+		return validateParam[ReplyKeyboardRemove](field, value)
+	}
+}